@@ -0,0 +1,114 @@
+package gl
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Context holds the function pointers resolved for one OpenGL context.
+//
+// The package-level functions always call through a single, package-wide set
+// of function pointers. On some platforms, most notably Windows, function
+// pointers are only valid for the context they were retrieved under, so an
+// application using several contexts that differ in version, profile, or
+// driver must make sure the package uses the right set. To do so, create one
+// Context per OpenGL context and call its MakeCurrent method right after
+// making the corresponding OpenGL context current.
+//
+// Every OpenGL function is also available as a method of Context, which makes
+// the Context current first if it is not already, so code holding a Context
+// does not depend on which one was made current last. Calling Init or
+// InitWithProcAddrFunc replaces the package-wide function pointers and leaves
+// no Context current, so the next method call on any Context makes it current
+// again.
+//
+// Like the OpenGL contexts themselves, switching contexts is not safe for
+// concurrent use: all OpenGL calls through this package must be made from one
+// goroutine at a time.
+type Context struct {
+	functions []unsafe.Pointer
+}
+
+// current is the Context last made current, or nil.
+var current *Context
+
+// resetCurrentContext forgets the current Context. InitWithProcAddrFunc calls
+// it before replacing the function pointers; the call is inserted into the
+// glow-generated package.go by genprocs.
+func resetCurrentContext() {
+	current = nil
+}
+
+// NewContext resolves all functions using getProcAddr, which must be called
+// under the OpenGL context the functions are retrieved for. The package-level
+// functions are not affected until MakeCurrent is called.
+func NewContext(getProcAddr func(name string) unsafe.Pointer) (*Context, error) {
+	if getProcAddr == nil {
+		return nil, errors.New("nil getProcAddr function")
+	}
+	c := &Context{functions: make([]unsafe.Pointer, len(procs))}
+	for i, p := range procs {
+		c.functions[i] = getProcAddr(p.name)
+	}
+	return c, nil
+}
+
+// CurrentContext returns a Context holding the function pointers the
+// package-level functions currently use, for example those loaded by Init.
+func CurrentContext() *Context {
+	c := &Context{functions: make([]unsafe.Pointer, len(procs))}
+	for i, p := range procs {
+		c.functions[i] = *p.ptr
+	}
+	return c
+}
+
+// MakeCurrent makes the package-level functions call through the function
+// pointers of c.
+func (c *Context) MakeCurrent() {
+	for i, p := range procs {
+		*p.ptr = c.functions[i]
+	}
+	current = c
+}
+
+// use makes c current unless it already is.
+func (c *Context) use() {
+	if current != c {
+		c.MakeCurrent()
+	}
+}
+
+// CoreFunctionsLoaded reports whether every function of the core profile of
+// the given OpenGL version is loaded for the package-level functions.
+//
+// Only the versions from 3.2 onwards that have their own bindings package are
+// tracked. For any other version the functions of the closest earlier tracked
+// version are checked, and for versions before 3.2 it always reports true.
+func CoreFunctionsLoaded(major, minor int) bool {
+	for _, v := range coreVersions {
+		if v.major > major || (v.major == major && v.minor > minor) {
+			break
+		}
+		for _, fn := range v.functions {
+			if *fn == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// FunctionLoaded reports whether the OpenGL function with the given name, such
+// as "glDrawTransformFeedback", is loaded for the package-level functions.
+// Drivers may report a version without resolving every function it requires,
+// or advertise an extension whose functions are missing, so checking the
+// functions a feature relies on is more reliable than the version alone.
+func FunctionLoaded(name string) bool {
+	for _, p := range procs {
+		if p.name == name {
+			return *p.ptr != nil
+		}
+	}
+	return false
+}
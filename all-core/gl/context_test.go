@@ -0,0 +1,90 @@
+package gl
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// procPtr returns the package-level function pointer loaded by name.
+func procPtr(name string) unsafe.Pointer {
+	for _, p := range procs {
+		if p.name == name {
+			return *p.ptr
+		}
+	}
+	return nil
+}
+
+// fakeLoader returns a getProcAddr function resolving the given names to
+// ptr, and all others to nil. The pointers are never called.
+func fakeLoader(ptr unsafe.Pointer, names ...string) func(string) unsafe.Pointer {
+	return func(name string) unsafe.Pointer {
+		if len(names) == 0 {
+			return ptr
+		}
+		for _, n := range names {
+			if n == name {
+				return ptr
+			}
+		}
+		return nil
+	}
+}
+
+func TestContexts(t *testing.T) {
+	saved := CurrentContext()
+	defer func() {
+		saved.MakeCurrent()
+		current = nil
+	}()
+
+	var a, b byte
+	ptrA, ptrB := unsafe.Pointer(&a), unsafe.Pointer(&b)
+	c1, err := NewContext(fakeLoader(ptrA, "glClear"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := NewContext(fakeLoader(ptrB))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1.MakeCurrent()
+	if got := procPtr("glClear"); got != ptrA {
+		t.Errorf("glClear = %p under c1, want %p", got, ptrA)
+	}
+	if !FunctionLoaded("glClear") || FunctionLoaded("glDrawArrays") {
+		t.Error("FunctionLoaded does not reflect c1's loader")
+	}
+	if CoreFunctionsLoaded(3, 2) {
+		t.Error("CoreFunctionsLoaded(3, 2) = true under c1, which only loads glClear")
+	}
+
+	c2.MakeCurrent()
+	if got := procPtr("glClear"); got != ptrB {
+		t.Errorf("glClear = %p under c2, want %p", got, ptrB)
+	}
+	if !FunctionLoaded("glDrawArrays") || !CoreFunctionsLoaded(4, 6) {
+		t.Error("c2 loads every function, but they are not reported as loaded")
+	}
+
+	// Methods switch to their Context as needed.
+	c1.use()
+	if current != c1 || procPtr("glDrawArrays") != nil {
+		t.Error("use did not make c1 current")
+	}
+
+	// Init replaces the function pointers, so no Context may stay current.
+	InitWithProcAddrFunc(fakeLoader(nil))
+	if current != nil {
+		t.Error("InitWithProcAddrFunc left a Context current")
+	}
+	c1.use()
+	if got := procPtr("glClear"); got != ptrA {
+		t.Errorf("glClear = %p after c1.use following Init, want %p", got, ptrA)
+	}
+
+	if _, err := NewContext(nil); err == nil {
+		t.Error("NewContext(nil) succeeded")
+	}
+}
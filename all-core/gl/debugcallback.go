@@ -0,0 +1,36 @@
+package gl
+
+// #if defined(_WIN32) && !defined(APIENTRY) && !defined(__CYGWIN__) && !defined(__SCITECH_SNAP__)
+// #ifndef WIN32_LEAN_AND_MEAN
+// #define WIN32_LEAN_AND_MEAN 1
+// #endif
+// #include <windows.h>
+// #endif
+// #ifndef APIENTRY
+// #define APIENTRY
+// #endif
+// #ifndef APIENTRYP
+// #define APIENTRYP APIENTRY *
+// #endif
+// #include <stddef.h>
+//
+// typedef void (APIENTRYP GPREMOVEDEBUGMESSAGECALLBACK)(const void *callback, const void *userParam);
+//
+// static void glowRemoveDebugMessageCallback(void *fnptr) {
+//   (*(GPREMOVEDEBUGMESSAGECALLBACK)fnptr)(NULL, NULL);
+// }
+import "C"
+import "unsafe"
+
+// RemoveDebugMessageCallback removes the callback installed with
+// DebugMessageCallback from the current context. DebugMessageCallback always
+// installs the bindings' own callback, even when passed nil, and while any
+// callback is installed debug messages are not logged for
+// glGetDebugMessageLog. It is a no-op if glDebugMessageCallback is not loaded.
+func RemoveDebugMessageCallback() {
+	userDebugCallback = nil
+	if gpDebugMessageCallback == nil {
+		return
+	}
+	C.glowRemoveDebugMessageCallback(unsafe.Pointer(gpDebugMessageCallback))
+}
@@ -17004,6 +17004,9 @@ func Init() error {
 // function pointer loading function. For more cases Init should be used
 // instead.
 func InitWithProcAddrFunc(getProcAddr func(name string) unsafe.Pointer) error {
+	// Inserted by genprocs: the function pointers of the current Context are
+	// about to be replaced.
+	resetCurrentContext()
 	gpAccum = (C.GPACCUM)(getProcAddr("glAccum"))
 	gpActiveProgramEXT = (C.GPACTIVEPROGRAMEXT)(getProcAddr("glActiveProgramEXT"))
 	gpActiveShaderProgram = (C.GPACTIVESHADERPROGRAM)(getProcAddr("glActiveShaderProgram"))
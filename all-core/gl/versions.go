@@ -0,0 +1,687 @@
+// Code generated by genprocs. DO NOT EDIT.
+
+package gl
+
+import "unsafe"
+
+// coreVersions lists, for each core profile version that has its own bindings
+// package in this repository, the functions it requires in addition to the
+// previous entry. The first entry covers all functions up to OpenGL 3.2.
+var coreVersions = []struct {
+	major, minor int
+	functions    []*unsafe.Pointer
+}{
+	{3, 2, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpActiveTexture)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpAttachShader)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBeginConditionalRender)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBeginQuery)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBeginTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindAttribLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindBufferBase)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindFragDataLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindFramebuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindRenderbuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindTexture)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindVertexArray)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendColor)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendEquation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendEquationSeparate)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendFunc)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendFuncSeparate)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlitFramebuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBufferData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCheckFramebufferStatus)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClampColor)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClear)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearBufferfi)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearBufferfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearBufferiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearBufferuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearColor)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearDepth)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearStencil)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClientWaitSync)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpColorMask)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpColorMaski)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompileShader)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTexImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTexImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTexImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTexSubImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTexSubImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTexSubImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTexImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTexImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTexSubImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTexSubImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTexSubImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateShader)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCullFace)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteBuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteFramebuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteQueries)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteRenderbuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteShader)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteSync)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteTextures)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteVertexArrays)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDepthFunc)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDepthMask)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDepthRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDetachShader)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDisable)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDisablei)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDisableVertexAttribArray)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawArrays)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawArraysInstanced)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawBuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElements)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElementsBaseVertex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElementsInstanced)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElementsInstancedBaseVertex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawRangeElements)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawRangeElementsBaseVertex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEnable)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEnablei)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEnableVertexAttribArray)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEndConditionalRender)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEndQuery)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEndTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFenceSync)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFinish)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFlush)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFlushMappedBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferRenderbuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferTexture)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferTexture1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferTexture2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferTexture3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferTextureLayer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFrontFace)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenBuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenerateMipmap)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenFramebuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenQueries)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenRenderbuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenTextures)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenVertexArrays)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveAttrib)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveUniform)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveUniformBlockiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveUniformBlockName)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveUniformName)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveUniformsiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetAttachedShaders)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetAttribLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetBooleani_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetBooleanv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetBufferParameteri64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetBufferParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetBufferPointerv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetCompressedTexImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetDoublev)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetError)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetFloatv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetFragDataLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetFramebufferAttachmentParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetInteger64i_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetInteger64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetIntegeri_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetIntegerv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetMultisamplefv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramInfoLog)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryObjectiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryObjectuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetRenderbufferParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetShaderInfoLog)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetShaderiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetShaderSource)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetString)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetStringi)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSynciv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexLevelParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexLevelParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexParameterIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexParameterIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTexParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTransformFeedbackVarying)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformBlockIndex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformIndices)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribdv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribPointerv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpHint)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsEnabled)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsEnabledi)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsFramebuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsQuery)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsRenderbuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsShader)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsSync)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsTexture)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsVertexArray)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpLineWidth)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpLinkProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpLogicOp)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMapBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMapBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawArrays)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawElements)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawElementsBaseVertex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPixelStoref)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPixelStorei)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPointParameterf)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPointParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPointParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPointParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPointSize)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPolygonMode)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPolygonOffset)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPrimitiveRestartIndex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProvokingVertex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpReadBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpReadPixels)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpRenderbufferStorage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpRenderbufferStorageMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSampleCoverage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSampleMaski)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpScissor)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpShaderSource)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpStencilFunc)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpStencilFuncSeparate)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpStencilMask)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpStencilMaskSeparate)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpStencilOp)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpStencilOpSeparate)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexImage2DMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexImage3DMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexParameterf)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexParameterIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexParameterIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexSubImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexSubImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexSubImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTransformFeedbackVaryings)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformBlockBinding)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix2x3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix2x4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix3x2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix3x4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix4x2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix4x3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUnmapBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUseProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpValidateProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib1d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib1dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib1f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib1fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib1s)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib1sv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib2d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib2f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib2s)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib2sv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib3d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib3f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib3s)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib3sv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4bv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Nbv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Niv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Nsv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Nub)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Nubv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Nuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4Nusv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4s)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4sv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4ubv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttrib4usv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI1i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI1iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI1ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI1uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI2i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI2iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI2ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI2uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI3i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI3iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI3ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI3uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4bv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4sv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4ubv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribI4usv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribIPointer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribPointer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpViewport)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpWaitSync)),
+	}},
+	{3, 3, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindFragDataLocationIndexed)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindSampler)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteSamplers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenSamplers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetFragDataIndex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryObjecti64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryObjectui64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSamplerParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSamplerParameterIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSamplerParameterIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSamplerParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsSampler)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpQueryCounter)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSamplerParameterf)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSamplerParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSamplerParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSamplerParameterIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSamplerParameterIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSamplerParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribDivisor)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP1ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP1uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP2ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP2uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP3ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP3uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP4ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribP4uiv)),
+	}},
+	{4, 1, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpActiveShaderProgram)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBeginQueryIndexed)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindProgramPipeline)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendEquationi)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendEquationSeparatei)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendFunci)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlendFuncSeparatei)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearDepthf)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateShaderProgramv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteProgramPipelines)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDeleteTransformFeedbacks)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDepthRangeArrayv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDepthRangef)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDepthRangeIndexed)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawArraysIndirect)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElementsIndirect)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawTransformFeedbackStream)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEndQueryIndexed)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenProgramPipelines)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenTransformFeedbacks)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveSubroutineName)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveSubroutineUniformiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveSubroutineUniformName)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetDoublei_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetFloati_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramBinary)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramPipelineInfoLog)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramPipelineiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramStageiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryIndexediv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetShaderPrecisionFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSubroutineIndex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetSubroutineUniformLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformdv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetUniformSubroutineuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexAttribLdv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsProgramPipeline)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpIsTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMinSampleShading)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPatchParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPatchParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPauseTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramBinary)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform1uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform2uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform3uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4f)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4i)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4ui)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniform4uiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix2x3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix2x3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix2x4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix2x4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix3x2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix3x2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix3x4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix3x4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix4fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix4x2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix4x2fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix4x3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpProgramUniformMatrix4x3fv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpReleaseShaderCompiler)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpResumeTransformFeedback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpScissorArrayv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpScissorIndexed)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpScissorIndexedv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpShaderBinary)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform1dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniform4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix2x3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix2x4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix3x2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix3x4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix4x2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformMatrix4x3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUniformSubroutinesuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUseProgramStages)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpValidateProgramPipeline)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL1d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL1dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL2d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL2dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL3d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL3dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL4d)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribL4dv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribLPointer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpViewportArrayv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpViewportIndexedf)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpViewportIndexedfv)),
+	}},
+	{4, 2, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindImageTexture)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawArraysInstancedBaseInstance)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElementsInstancedBaseInstance)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawElementsInstancedBaseVertexBaseInstance)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawTransformFeedbackInstanced)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDrawTransformFeedbackStreamInstanced)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetActiveAtomicCounterBufferiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetInternalformativ)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMemoryBarrier)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexStorage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexStorage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexStorage3D)),
+	}},
+	{4, 3, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindVertexBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearBufferData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyImageSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDebugMessageCallback)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDebugMessageControl)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDebugMessageInsert)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDispatchCompute)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDispatchComputeIndirect)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFramebufferParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetDebugMessageLog)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetFramebufferParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetInternalformati64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetObjectLabel)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetObjectPtrLabel)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetPointerv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramInterfaceiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramResourceIndex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramResourceiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramResourceLocation)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramResourceLocationIndex)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetProgramResourceName)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateBufferData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateFramebuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateSubFramebuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateTexImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateTexSubImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawArraysIndirect)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawElementsIndirect)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpObjectLabel)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpObjectPtrLabel)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPopDebugGroup)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPushDebugGroup)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpShaderStorageBlockBinding)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexStorage2DMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTexStorage3DMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureView)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribBinding)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribIFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexAttribLFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexBindingDivisor)),
+	}},
+	{4, 4, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindBuffersBase)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindBuffersRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindImageTextures)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindSamplers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindTextures)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindVertexBuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBufferStorage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearTexImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearTexSubImage)),
+	}},
+	{4, 5, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBindTextureUnit)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpBlitNamedFramebuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCheckNamedFramebufferStatus)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearNamedBufferData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearNamedBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearNamedFramebufferfi)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearNamedFramebufferfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearNamedFramebufferiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClearNamedFramebufferuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpClipControl)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTextureSubImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTextureSubImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCompressedTextureSubImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyNamedBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTextureSubImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTextureSubImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCopyTextureSubImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateBuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateFramebuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateProgramPipelines)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateQueries)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateRenderbuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateSamplers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateTextures)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateTransformFeedbacks)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpCreateVertexArrays)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpDisableVertexArrayAttrib)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpEnableVertexArrayAttrib)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpFlushMappedNamedBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGenerateTextureMipmap)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetCompressedTextureImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetCompressedTextureSubImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetGraphicsResetStatus)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedBufferParameteri64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedBufferParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedBufferPointerv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedFramebufferAttachmentParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedFramebufferParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetNamedRenderbufferParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetnCompressedTexImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetnTexImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetnUniformdv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetnUniformfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetnUniformiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetnUniformuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryBufferObjecti64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryBufferObjectiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryBufferObjectui64v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetQueryBufferObjectuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureLevelParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureLevelParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureParameterIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureParameterIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTextureSubImage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTransformFeedbacki64_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTransformFeedbacki_v)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetTransformFeedbackiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexArrayIndexed64iv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexArrayIndexediv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpGetVertexArrayiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateNamedFramebufferData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpInvalidateNamedFramebufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMapNamedBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMapNamedBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMemoryBarrierByRegion)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedBufferData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedBufferStorage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedBufferSubData)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferDrawBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferDrawBuffers)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferReadBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferRenderbuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferTexture)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedFramebufferTextureLayer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedRenderbufferStorage)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpNamedRenderbufferStorageMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpReadnPixels)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureBarrier)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureParameterf)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureParameterfv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureParameteri)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureParameterIiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureParameterIuiv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureParameteriv)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureStorage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureStorage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureStorage2DMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureStorage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureStorage3DMultisample)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureSubImage1D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureSubImage2D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTextureSubImage3D)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTransformFeedbackBufferBase)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpTransformFeedbackBufferRange)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpUnmapNamedBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayAttribBinding)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayAttribFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayAttribIFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayAttribLFormat)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayBindingDivisor)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayElementBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayVertexBuffer)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpVertexArrayVertexBuffers)),
+	}},
+	{4, 6, []*unsafe.Pointer{
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawArraysIndirectCount)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpMultiDrawElementsIndirectCount)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpPolygonOffsetClamp)),
+		(*unsafe.Pointer)(unsafe.Pointer(&gpSpecializeShader)),
+	}},
+}
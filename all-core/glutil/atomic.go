@@ -0,0 +1,62 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// atomicCountersSupported reports whether atomic counter buffers are
+// available.
+func atomicCountersSupported() bool {
+	return versionAtLeast(4, 2) || extensionSupported("GL_ARB_shader_atomic_counters")
+}
+
+// NewAtomicCounterBuffer creates a buffer holding count zeroed atomic counters
+// of type atomic_uint, to be bound with glBindBufferBase to a
+// gl.ATOMIC_COUNTER_BUFFER binding point.
+//
+// NewAtomicCounterBuffer returns zero before OpenGL 4.2 unless
+// GL_ARB_shader_atomic_counters is supported.
+func NewAtomicCounterBuffer(count int) uint32 {
+	if !atomicCountersSupported() || count <= 0 {
+		return 0
+	}
+	prev := boundBuffer(gl.ATOMIC_COUNTER_BUFFER)
+	defer gl.BindBuffer(gl.ATOMIC_COUNTER_BUFFER, prev)
+
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	gl.BindBuffer(gl.ATOMIC_COUNTER_BUFFER, buf)
+	zeros := make([]uint32, count)
+	gl.BufferData(gl.ATOMIC_COUNTER_BUFFER, 4*count, gl.Ptr(zeros), gl.DYNAMIC_COPY)
+	return buf
+}
+
+// ResetAtomicCounters sets the first len(values) counters of buffer to values,
+// typically once per frame before the shaders incrementing them run. It is a
+// no-op if atomic counters are not supported.
+func ResetAtomicCounters(buffer uint32, values []uint32) {
+	if !atomicCountersSupported() || len(values) == 0 {
+		return
+	}
+	prev := boundBuffer(gl.ATOMIC_COUNTER_BUFFER)
+	defer gl.BindBuffer(gl.ATOMIC_COUNTER_BUFFER, prev)
+
+	gl.BindBuffer(gl.ATOMIC_COUNTER_BUFFER, buffer)
+	gl.BufferSubData(gl.ATOMIC_COUNTER_BUFFER, 0, 4*len(values), gl.Ptr(values))
+}
+
+// ReadAtomicCounters returns the values of the first count counters of
+// buffer. It issues a buffer update memory barrier first, so that increments
+// made by previously executed shaders are visible. It returns nil if atomic
+// counters are not supported.
+func ReadAtomicCounters(buffer uint32, count int) []uint32 {
+	if !atomicCountersSupported() || count <= 0 {
+		return nil
+	}
+	prev := boundBuffer(gl.ATOMIC_COUNTER_BUFFER)
+	defer gl.BindBuffer(gl.ATOMIC_COUNTER_BUFFER, prev)
+
+	gl.MemoryBarrier(gl.BUFFER_UPDATE_BARRIER_BIT)
+	gl.BindBuffer(gl.ATOMIC_COUNTER_BUFFER, buffer)
+	values := make([]uint32, count)
+	gl.GetBufferSubData(gl.ATOMIC_COUNTER_BUFFER, 0, 4*count, gl.Ptr(values))
+	return values
+}
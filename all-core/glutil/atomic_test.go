@@ -0,0 +1,46 @@
+package glutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+const countShader = `#version 430 core
+layout(local_size_x = 8) in;
+layout(binding = 0, offset = 0) uniform atomic_uint invocations;
+layout(binding = 0, offset = 4) uniform atomic_uint odd;
+void main() {
+	atomicCounterIncrement(invocations);
+	if ((gl_GlobalInvocationID.x & 1u) == 1u) {
+		atomicCounterIncrement(odd);
+	}
+}
+`
+
+func TestAtomicCounters(t *testing.T) {
+	defer testContext(t)()
+	requireContextVersion(t, 4, 3)
+	buf := NewAtomicCounterBuffer(2)
+	defer gl.DeleteBuffers(1, &buf)
+	if got := ReadAtomicCounters(buf, 2); !reflect.DeepEqual(got, []uint32{0, 0}) {
+		t.Errorf("new counters = %v, want [0 0]", got)
+	}
+	ResetAtomicCounters(buf, []uint32{100, 0})
+
+	program, err := linkProgram(shaderSource{gl.COMPUTE_SHADER, countShader})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	gl.BindBufferBase(gl.ATOMIC_COUNTER_BUFFER, 0, buf)
+	defer gl.BindBufferBase(gl.ATOMIC_COUNTER_BUFFER, 0, 0)
+	WithProgram(program, func() {
+		gl.DispatchCompute(4, 1, 1)
+	})
+	if got := ReadAtomicCounters(buf, 2); !reflect.DeepEqual(got, []uint32{132, 16}) {
+		t.Errorf("counters after dispatch = %v, want [132 16]", got)
+	}
+	checkErrors(t)
+}
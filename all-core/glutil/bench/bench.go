@@ -0,0 +1,40 @@
+// Package bench provides helpers for measuring the CPU-side cost of calling
+// into OpenGL.
+//
+// Like glutil, it is built on the all-core bindings and requires gl.Init to
+// have been called under an active OpenGL context.
+package bench
+
+import (
+	"time"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// now returns the current time. It is replaced by tests.
+var now = time.Now
+
+// MeasureCallOverhead calls fn iterations times in a tight loop and returns the
+// average wall-clock time of a single call. If fn is nil, gl.GetError is
+// called, which does almost no work in the driver, so the result approximates
+// the fixed cost of one cgo call into the driver on this host.
+//
+// The result only covers CPU time spent until the call returns; work the
+// driver defers to the GPU is not included. If the overhead is a significant
+// fraction of a frame budget when multiplied by the number of calls made per
+// frame, batching those calls (for example with instancing or multi-draw) is
+// likely to pay off. Use enough iterations, at least several thousand, for the
+// timer resolution not to dominate the result.
+func MeasureCallOverhead(iterations int, fn func()) time.Duration {
+	if iterations <= 0 {
+		return 0
+	}
+	if fn == nil {
+		fn = func() { gl.GetError() }
+	}
+	start := now()
+	for i := 0; i < iterations; i++ {
+		fn()
+	}
+	return now().Sub(start) / time.Duration(iterations)
+}
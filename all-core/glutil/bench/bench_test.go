@@ -0,0 +1,48 @@
+package bench
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-gl/gl/internal/gltest"
+)
+
+// fakeClock replaces now with a clock that only advances when told to, and
+// returns a function restoring the real clock.
+func fakeClock(clock *time.Time) (restore func()) {
+	now = func() time.Time { return *clock }
+	return func() { now = time.Now }
+}
+
+func TestMeasureCallOverhead(t *testing.T) {
+	var clock time.Time
+	defer fakeClock(&clock)()
+
+	calls := 0
+	got := MeasureCallOverhead(4, func() {
+		calls++
+		clock = clock.Add(3 * time.Millisecond)
+	})
+	if got != 3*time.Millisecond || calls != 4 {
+		t.Errorf("MeasureCallOverhead(4, fn) = %v after %d calls, want 3ms after 4", got, calls)
+	}
+
+	calls = 0
+	for _, iterations := range []int{0, -1} {
+		if got := MeasureCallOverhead(iterations, func() { calls++ }); got != 0 || calls != 0 {
+			t.Errorf("MeasureCallOverhead(%d, fn) = %v after %d calls, want 0 after none", iterations, got, calls)
+		}
+	}
+}
+
+// BenchmarkCallOverhead measures the fixed cost of a call into the driver of
+// the shared headless test context, skipping if no context can be created.
+func BenchmarkCallOverhead(b *testing.B) {
+	release, err := gltest.Acquire()
+	if err != nil {
+		b.Skipf("no OpenGL context: %v", err)
+	}
+	defer release()
+	b.ResetTimer()
+	MeasureCallOverhead(b.N, nil)
+}
@@ -0,0 +1,16 @@
+package bench_test
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil/bench"
+)
+
+// This example compares the cost of a state change against the fixed cost of
+// a call into the driver. It requires a current OpenGL context.
+func ExampleMeasureCallOverhead() {
+	base := bench.MeasureCallOverhead(10000, nil)
+	enable := bench.MeasureCallOverhead(10000, func() { gl.Enable(gl.DEPTH_TEST) })
+	fmt.Printf("call: %v, glEnable: %v\n", base, enable)
+}
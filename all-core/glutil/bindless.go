@@ -0,0 +1,42 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// SupportsBindlessTextures reports whether the current context supports
+// GL_ARB_bindless_texture.
+func SupportsBindlessTextures() bool {
+	return extensionSupported("GL_ARB_bindless_texture")
+}
+
+// TextureHandle returns the bindless handle of texture, which must be complete.
+// Once a handle has been obtained the texture's state becomes immutable. The
+// handle must be made resident with SetTextureHandleResident before shaders
+// access it.
+//
+// TextureHandle returns zero if bindless textures are not supported.
+func TextureHandle(texture uint32) uint64 {
+	if !SupportsBindlessTextures() {
+		return 0
+	}
+	return gl.GetTextureHandleARB(texture)
+}
+
+// SetTextureHandleResident makes a bindless texture handle resident or
+// non-resident. Changing the residency to its current value is a no-op, as
+// is calling it when bindless textures are not supported.
+func SetTextureHandleResident(handle uint64, resident bool) {
+	if !SupportsBindlessTextures() || gl.IsTextureHandleResidentARB(handle) == resident {
+		return
+	}
+	if resident {
+		gl.MakeTextureHandleResidentARB(handle)
+	} else {
+		gl.MakeTextureHandleNonResidentARB(handle)
+	}
+}
+
+// TextureHandleResident reports whether a bindless texture handle is resident.
+// It returns false if bindless textures are not supported.
+func TextureHandleResident(handle uint64) bool {
+	return SupportsBindlessTextures() && gl.IsTextureHandleResidentARB(handle)
+}
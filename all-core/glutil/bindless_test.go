@@ -0,0 +1,36 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestTextureHandle(t *testing.T) {
+	defer testContext(t)()
+	tex, deleteTex := newTestTexture(t, 4, 4)
+	defer deleteTex()
+	gl.BindTexture(gl.TEXTURE_2D, 0)
+
+	if !SupportsBindlessTextures() {
+		if handle := TextureHandle(tex); handle != 0 {
+			t.Errorf("TextureHandle() = %d without bindless texture support, want 0", handle)
+		}
+		t.Skip("GL_ARB_bindless_texture is not supported")
+	}
+	handle := TextureHandle(tex)
+	if handle == 0 {
+		t.Fatal("TextureHandle() = 0")
+	}
+	SetTextureHandleResident(handle, true)
+	if !TextureHandleResident(handle) {
+		t.Error("handle is not resident after SetTextureHandleResident(true)")
+	}
+	// Setting the same residency again must not be an error.
+	SetTextureHandleResident(handle, true)
+	SetTextureHandleResident(handle, false)
+	if TextureHandleResident(handle) {
+		t.Error("handle is resident after SetTextureHandleResident(false)")
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,47 @@
+package glutil
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// BlendMode is a commonly used combination of blend factors for SetBlendMode.
+type BlendMode int
+
+// Blend modes supported by SetBlendMode.
+const (
+	// BlendAlpha is regular alpha blending for colors with straight alpha.
+	BlendAlpha BlendMode = iota
+	// BlendPremultiplied is alpha blending for colors with premultiplied
+	// alpha.
+	BlendPremultiplied
+	// BlendAdditive adds the source to the destination, as used for lights
+	// and particles.
+	BlendAdditive
+	// BlendMultiply multiplies the destination color by the source color and
+	// leaves the destination alpha unchanged.
+	BlendMultiply
+)
+
+// blendFactors holds the glBlendFuncSeparate factors of each BlendMode.
+var blendFactors = map[BlendMode][4]uint32{
+	BlendAlpha:         {gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA},
+	BlendPremultiplied: {gl.ONE, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA},
+	BlendAdditive:      {gl.ONE, gl.ONE, gl.ONE, gl.ONE},
+	BlendMultiply:      {gl.DST_COLOR, gl.ZERO, gl.ZERO, gl.ONE},
+}
+
+// SetBlendMode enables blending and sets the blend equation and factors for
+// mode. An error is returned, and the blend state is left unchanged, for an
+// unknown mode.
+func SetBlendMode(mode BlendMode) error {
+	f, ok := blendFactors[mode]
+	if !ok {
+		return fmt.Errorf("unknown blend mode %d", mode)
+	}
+	gl.Enable(gl.BLEND)
+	gl.BlendEquation(gl.FUNC_ADD)
+	gl.BlendFuncSeparate(f[0], f[1], f[2], f[3])
+	return nil
+}
@@ -0,0 +1,54 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSetBlendMode(t *testing.T) {
+	defer testContext(t)()
+	defer func() {
+		gl.Disable(gl.BLEND)
+		gl.BlendFunc(gl.ONE, gl.ZERO)
+	}()
+	for _, m := range []struct {
+		name string
+		mode BlendMode
+		want [4]int32
+	}{
+		{"BlendAlpha", BlendAlpha, [4]int32{gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA}},
+		{"BlendPremultiplied", BlendPremultiplied, [4]int32{gl.ONE, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA}},
+		{"BlendAdditive", BlendAdditive, [4]int32{gl.ONE, gl.ONE, gl.ONE, gl.ONE}},
+		{"BlendMultiply", BlendMultiply, [4]int32{gl.DST_COLOR, gl.ZERO, gl.ZERO, gl.ONE}},
+	} {
+		gl.Disable(gl.BLEND)
+		if err := SetBlendMode(m.mode); err != nil {
+			t.Fatalf("%s: %v", m.name, err)
+		}
+		if !gl.IsEnabled(gl.BLEND) {
+			t.Errorf("%s: blending is not enabled", m.name)
+		}
+		got := [4]int32{
+			getInteger(gl.BLEND_SRC_RGB),
+			getInteger(gl.BLEND_DST_RGB),
+			getInteger(gl.BLEND_SRC_ALPHA),
+			getInteger(gl.BLEND_DST_ALPHA),
+		}
+		if got != m.want {
+			t.Errorf("%s: blend factors = %#x, want %#x", m.name, got, m.want)
+		}
+		if eq := getInteger(gl.BLEND_EQUATION_RGB); eq != gl.FUNC_ADD {
+			t.Errorf("%s: blend equation = 0x%X, want FUNC_ADD", m.name, eq)
+		}
+	}
+
+	gl.Disable(gl.BLEND)
+	if err := SetBlendMode(BlendMode(-1)); err == nil {
+		t.Error("SetBlendMode accepted an unknown mode")
+	}
+	if gl.IsEnabled(gl.BLEND) {
+		t.Error("SetBlendMode enabled blending for an unknown mode")
+	}
+	checkErrors(t)
+}
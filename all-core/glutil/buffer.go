@@ -0,0 +1,143 @@
+package glutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// bufferBindings maps buffer targets to the state holding their binding.
+var bufferBindings = map[uint32]uint32{
+	gl.ARRAY_BUFFER:              gl.ARRAY_BUFFER_BINDING,
+	gl.ATOMIC_COUNTER_BUFFER:     gl.ATOMIC_COUNTER_BUFFER_BINDING,
+	gl.COPY_READ_BUFFER:          gl.COPY_READ_BUFFER_BINDING,
+	gl.COPY_WRITE_BUFFER:         gl.COPY_WRITE_BUFFER_BINDING,
+	gl.DISPATCH_INDIRECT_BUFFER:  gl.DISPATCH_INDIRECT_BUFFER_BINDING,
+	gl.DRAW_INDIRECT_BUFFER:      gl.DRAW_INDIRECT_BUFFER_BINDING,
+	gl.ELEMENT_ARRAY_BUFFER:      gl.ELEMENT_ARRAY_BUFFER_BINDING,
+	gl.PIXEL_PACK_BUFFER:         gl.PIXEL_PACK_BUFFER_BINDING,
+	gl.PIXEL_UNPACK_BUFFER:       gl.PIXEL_UNPACK_BUFFER_BINDING,
+	gl.QUERY_BUFFER:              gl.QUERY_BUFFER_BINDING,
+	gl.SHADER_STORAGE_BUFFER:     gl.SHADER_STORAGE_BUFFER_BINDING,
+	gl.TEXTURE_BUFFER:            gl.TEXTURE_BUFFER_BINDING,
+	gl.TRANSFORM_FEEDBACK_BUFFER: gl.TRANSFORM_FEEDBACK_BUFFER_BINDING,
+	gl.UNIFORM_BUFFER:            gl.UNIFORM_BUFFER_BINDING,
+}
+
+// boundBuffer returns the buffer currently bound to target, or zero if none is
+// bound or the target is unknown.
+func boundBuffer(target uint32) uint32 {
+	binding, ok := bufferBindings[target]
+	if !ok {
+		return 0
+	}
+	return uint32(getInteger(binding))
+}
+
+// sliceData returns the address and size in bytes of the elements of data,
+// which must be a slice of fixed-size values. The address is nil for an empty
+// slice.
+func sliceData(data interface{}) (unsafe.Pointer, int, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return nil, 0, fmt.Errorf("unsupported type %T; must be a slice", data)
+	}
+	if v.Len() == 0 {
+		return nil, 0, nil
+	}
+	return unsafe.Pointer(v.Index(0).UnsafeAddr()), v.Len() * int(v.Type().Elem().Size()), nil
+}
+
+// NewStorageBuffer creates a buffer for target with immutable storage
+// holding a copy of the elements of data, which must be a slice such as a
+// []float32 or a slice of structs. The flags are those of glBufferStorage, for
+// example gl.MAP_WRITE_BIT|gl.MAP_PERSISTENT_BIT|gl.MAP_COHERENT_BIT for a
+// persistently mapped buffer.
+//
+// Flag combinations that glBufferStorage rejects, such as gl.MAP_PERSISTENT_BIT
+// without read or write access, are reported as errors. NewStorageBuffer
+// requires OpenGL 4.4 or GL_ARB_buffer_storage. The previous binding of target
+// is restored on return.
+func NewStorageBuffer(target uint32, data interface{}, flags uint32) (uint32, error) {
+	if !versionAtLeast(4, 4) && !extensionSupported("GL_ARB_buffer_storage") {
+		return 0, requireVersion("glBufferStorage", 4, 4)
+	}
+	if flags&gl.MAP_PERSISTENT_BIT != 0 && flags&(gl.MAP_READ_BIT|gl.MAP_WRITE_BIT) == 0 {
+		return 0, errors.New("MAP_PERSISTENT_BIT requires MAP_READ_BIT or MAP_WRITE_BIT")
+	}
+	if flags&gl.MAP_COHERENT_BIT != 0 && flags&gl.MAP_PERSISTENT_BIT == 0 {
+		return 0, errors.New("MAP_COHERENT_BIT requires MAP_PERSISTENT_BIT")
+	}
+	ptr, size, err := sliceData(data)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 {
+		return 0, errors.New("buffer storage must not be empty")
+	}
+
+	prev := boundBuffer(target)
+	defer gl.BindBuffer(target, prev)
+
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	gl.BindBuffer(target, buf)
+	gl.BufferStorage(target, size, ptr, flags)
+	return buf, nil
+}
+
+// OrphanBuffer replaces the storage of the buffer bound to target with a new,
+// uninitialized allocation of sizeBytes. Draw calls still reading the old
+// storage are unaffected, so new data can be written with glBufferSubData or
+// glMapBufferRange right away without waiting for the GPU.
+//
+// Orphaning works for any mutable buffer and on any OpenGL version, but the
+// driver may allocate new memory every time. Where OpenGL 4.4 is available a
+// persistently mapped buffer created by NewStorageBuffer, with fences
+// guarding the regions in use, avoids these reallocations.
+func OrphanBuffer(target uint32, sizeBytes int, usage uint32) {
+	gl.BufferData(target, sizeBytes, nil, usage)
+}
+
+// BufferInfo returns the size in bytes, usage hint and mapping status of the
+// buffer bound to target. It returns zero values if no buffer is bound.
+func BufferInfo(target uint32) (size int, usage uint32, mapped bool) {
+	if boundBuffer(target) == 0 {
+		return 0, 0, false
+	}
+	var size64 int64
+	gl.GetBufferParameteri64v(target, gl.BUFFER_SIZE, &size64)
+	var u, m int32
+	gl.GetBufferParameteriv(target, gl.BUFFER_USAGE, &u)
+	gl.GetBufferParameteriv(target, gl.BUFFER_MAPPED, &m)
+	return int(size64), uint32(u), m == gl.TRUE
+}
+
+// FlushMappedRange flushes lengthElems elements, starting at element
+// offsetElems, of the buffer bound to target, which must be mapped with
+// gl.MAP_FLUSH_EXPLICIT_BIT. Without flushing, writes to such a mapping may
+// never reach the GPU. The element type is that of sample, such as float32(0)
+// or a vertex struct value, and offsets are relative to the start of the
+// mapped range.
+//
+// An error is returned if sample is nil or the range is negative or does not
+// fit in an int once converted to bytes. A range past the end of the mapping
+// is left for OpenGL to reject with gl.INVALID_VALUE.
+func FlushMappedRange(target uint32, sample interface{}, offsetElems, lengthElems int) error {
+	if sample == nil {
+		return errors.New("nil element sample")
+	}
+	if offsetElems < 0 || lengthElems < 0 {
+		return fmt.Errorf("negative flush range %d+%d", offsetElems, lengthElems)
+	}
+	size := int(reflect.TypeOf(sample).Size())
+	const maxInt = int(^uint(0) >> 1)
+	if size > 0 && (offsetElems > maxInt/size || lengthElems > maxInt/size-offsetElems) {
+		return fmt.Errorf("flush range %d+%d of %d byte elements overflows", offsetElems, lengthElems, size)
+	}
+	gl.FlushMappedBufferRange(target, offsetElems*size, lengthElems*size)
+	return nil
+}
@@ -0,0 +1,172 @@
+package glutil
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// mappedFloats returns the n float32 values starting at ptr.
+func mappedFloats(ptr unsafe.Pointer, n int) []float32 {
+	var s []float32
+	h := (*reflect.SliceHeader)(unsafe.Pointer(&s))
+	h.Data, h.Len, h.Cap = uintptr(ptr), n, n
+	return s
+}
+
+// bufferFloats reads back the first n float32 values of the buffer bound to
+// target.
+func bufferFloats(target uint32, n int) []float32 {
+	data := make([]float32, n)
+	gl.GetBufferSubData(target, 0, 4*n, gl.Ptr(data))
+	return data
+}
+
+func TestNewStorageBuffer(t *testing.T) {
+	defer testContext(t)()
+	if !versionAtLeast(4, 4) && !extensionSupported("GL_ARB_buffer_storage") {
+		if _, err := NewStorageBuffer(gl.ARRAY_BUFFER, []float32{1}, 0); err == nil {
+			t.Error("NewStorageBuffer succeeded without buffer storage support")
+		}
+		return
+	}
+	if _, err := NewStorageBuffer(gl.ARRAY_BUFFER, []float32{1}, gl.MAP_PERSISTENT_BIT); err == nil {
+		t.Error("NewStorageBuffer accepted MAP_PERSISTENT_BIT without read or write access")
+	}
+	if _, err := NewStorageBuffer(gl.ARRAY_BUFFER, []float32{1}, gl.MAP_READ_BIT|gl.MAP_COHERENT_BIT); err == nil {
+		t.Error("NewStorageBuffer accepted MAP_COHERENT_BIT without MAP_PERSISTENT_BIT")
+	}
+	if _, err := NewStorageBuffer(gl.ARRAY_BUFFER, float32(1), gl.MAP_READ_BIT); err == nil {
+		t.Error("NewStorageBuffer accepted data that is not a slice")
+	}
+
+	data := []float32{1, 2, 3, 4}
+	flags := uint32(gl.MAP_READ_BIT | gl.MAP_PERSISTENT_BIT | gl.MAP_COHERENT_BIT)
+	buf, err := NewStorageBuffer(gl.ARRAY_BUFFER, data, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteBuffers(1, &buf)
+	if bound := boundBuffer(gl.ARRAY_BUFFER); bound != 0 {
+		t.Errorf("array buffer binding after NewStorageBuffer = %d, want 0", bound)
+	}
+
+	gl.BindBuffer(gl.ARRAY_BUFFER, buf)
+	defer gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+	var immutable int32
+	gl.GetBufferParameteriv(gl.ARRAY_BUFFER, gl.BUFFER_IMMUTABLE_STORAGE, &immutable)
+	if immutable != gl.TRUE {
+		t.Error("BUFFER_IMMUTABLE_STORAGE is not set")
+	}
+	ptr := gl.MapBufferRange(gl.ARRAY_BUFFER, 0, 4*len(data), flags)
+	if ptr == nil {
+		t.Fatal("glMapBufferRange failed")
+	}
+	if got := mappedFloats(ptr, len(data)); !reflect.DeepEqual(got, data) {
+		t.Errorf("mapped buffer = %v, want %v", got, data)
+	}
+	gl.UnmapBuffer(gl.ARRAY_BUFFER)
+	checkErrors(t)
+}
+
+func TestOrphanBuffer(t *testing.T) {
+	defer testContext(t)()
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	defer gl.DeleteBuffers(1, &buf)
+	gl.BindBuffer(gl.ARRAY_BUFFER, buf)
+	defer gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+	old := []float32{1, 2, 3, 4}
+	gl.BufferData(gl.ARRAY_BUFFER, 4*len(old), gl.Ptr(old), gl.STREAM_DRAW)
+
+	OrphanBuffer(gl.ARRAY_BUFFER, 4*8, gl.STREAM_DRAW)
+	if size, usage, _ := BufferInfo(gl.ARRAY_BUFFER); size != 4*8 || usage != gl.STREAM_DRAW {
+		t.Errorf("orphaned buffer has size %d and usage 0x%X, want 32 and STREAM_DRAW", size, usage)
+	}
+	data := []float32{5, 6, 7, 8, 9, 10, 11, 12}
+	gl.BufferSubData(gl.ARRAY_BUFFER, 0, 4*len(data), gl.Ptr(data))
+	if got := bufferFloats(gl.ARRAY_BUFFER, len(data)); !reflect.DeepEqual(got, data) {
+		t.Errorf("buffer after orphaning = %v, want %v", got, data)
+	}
+	checkErrors(t)
+}
+
+func TestBufferInfo(t *testing.T) {
+	defer testContext(t)()
+	if size, usage, mapped := BufferInfo(gl.UNIFORM_BUFFER); size != 0 || usage != 0 || mapped {
+		t.Errorf("BufferInfo() without a bound buffer = %d, 0x%X, %v; want zero values", size, usage, mapped)
+	}
+
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	defer gl.DeleteBuffers(1, &buf)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, buf)
+	defer gl.BindBuffer(gl.UNIFORM_BUFFER, 0)
+	gl.BufferData(gl.UNIFORM_BUFFER, 256, nil, gl.DYNAMIC_DRAW)
+	if size, usage, mapped := BufferInfo(gl.UNIFORM_BUFFER); size != 256 || usage != gl.DYNAMIC_DRAW || mapped {
+		t.Errorf("BufferInfo() = %d, 0x%X, %v; want 256, DYNAMIC_DRAW, false", size, usage, mapped)
+	}
+	gl.MapBufferRange(gl.UNIFORM_BUFFER, 0, 16, gl.MAP_WRITE_BIT)
+	if _, _, mapped := BufferInfo(gl.UNIFORM_BUFFER); !mapped {
+		t.Error("BufferInfo() reports a mapped buffer as unmapped")
+	}
+	gl.UnmapBuffer(gl.UNIFORM_BUFFER)
+	checkErrors(t)
+}
+
+func TestFlushMappedRange(t *testing.T) {
+	defer testContext(t)()
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	defer gl.DeleteBuffers(1, &buf)
+	gl.BindBuffer(gl.ARRAY_BUFFER, buf)
+	defer gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+	gl.BufferData(gl.ARRAY_BUFFER, 4*8, gl.Ptr(make([]float32, 8)), gl.DYNAMIC_DRAW)
+
+	// Map the second half and flush the middle two of its four elements.
+	ptr := gl.MapBufferRange(gl.ARRAY_BUFFER, 4*4, 4*4, gl.MAP_WRITE_BIT|gl.MAP_FLUSH_EXPLICIT_BIT)
+	if ptr == nil {
+		t.Fatal("glMapBufferRange failed")
+	}
+	copy(mappedFloats(ptr, 4), []float32{1, 2, 3, 4})
+	if err := FlushMappedRange(gl.ARRAY_BUFFER, float32(0), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	// Offsets of struct elements are scaled by the struct size, so this
+	// flushes the whole mapped range.
+	if err := FlushMappedRange(gl.ARRAY_BUFFER, struct{ X, Y float32 }{}, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	gl.UnmapBuffer(gl.ARRAY_BUFFER)
+	checkErrors(t)
+
+	got := bufferFloats(gl.ARRAY_BUFFER, 8)
+	if want := []float32{0, 0, 0, 0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("buffer after flushing = %v, want %v", got, want)
+	}
+
+	// Flushing past the end of the mapped range must fail.
+	gl.MapBufferRange(gl.ARRAY_BUFFER, 0, 4*4, gl.MAP_WRITE_BIT|gl.MAP_FLUSH_EXPLICIT_BIT)
+	FlushMappedRange(gl.ARRAY_BUFFER, struct{ X, Y float32 }{}, 1, 2)
+	for _, tt := range []struct {
+		name           string
+		sample         interface{}
+		offset, length int
+	}{
+		{"nil sample", nil, 0, 1},
+		{"negative offset", float32(0), -1, 1},
+		{"negative length", float32(0), 0, -1},
+		{"overflowing range", float32(0), int(^uint(0) >> 3), 1},
+	} {
+		if err := FlushMappedRange(gl.ARRAY_BUFFER, tt.sample, tt.offset, tt.length); err == nil {
+			t.Errorf("FlushMappedRange accepted a %s", tt.name)
+		}
+	}
+	gl.UnmapBuffer(gl.ARRAY_BUFFER)
+	if err := gl.GetError(); err != gl.INVALID_VALUE {
+		t.Errorf("GL error after flushing past the mapped range = 0x%X, want INVALID_VALUE", err)
+	}
+	checkErrors(t)
+}
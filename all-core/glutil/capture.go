@@ -0,0 +1,99 @@
+package glutil
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// State is a snapshot of a curated set of OpenGL state, as captured by
+// CaptureState. It is comparable with ==, and Diff describes how two
+// snapshots differ, so that tests can check that a renderer leaves the state
+// as it found it.
+type State struct {
+	Blend       bool
+	CullFace    bool
+	DepthTest   bool
+	ScissorTest bool
+	StencilTest bool
+
+	BlendSrcRGB         uint32
+	BlendDstRGB         uint32
+	BlendSrcAlpha       uint32
+	BlendDstAlpha       uint32
+	BlendEquationRGB    uint32
+	BlendEquationAlpha  uint32
+	CullFaceMode        uint32
+	FrontFace           uint32
+	DepthFunc           uint32
+	DepthWriteMask      bool
+	ColorWriteMask      [4]bool
+	Viewport            [4]int32
+	ScissorBox          [4]int32
+	Program             uint32
+	VertexArray         uint32
+	ArrayBuffer         uint32
+	ElementArrayBuffer  uint32
+	DrawFramebuffer     uint32
+	ReadFramebuffer     uint32
+	ActiveTexture       uint32
+	TextureBinding2D    uint32
+	UniformBuffer       uint32
+	PixelUnpackBuffer   uint32
+	PixelPackBuffer     uint32
+	RenderbufferBinding uint32
+}
+
+// CaptureState returns a snapshot of the current state. Texture bindings are
+// those of the active texture unit.
+func CaptureState() State {
+	var s State
+	s.Blend = gl.IsEnabled(gl.BLEND)
+	s.CullFace = gl.IsEnabled(gl.CULL_FACE)
+	s.DepthTest = gl.IsEnabled(gl.DEPTH_TEST)
+	s.ScissorTest = gl.IsEnabled(gl.SCISSOR_TEST)
+	s.StencilTest = gl.IsEnabled(gl.STENCIL_TEST)
+
+	s.BlendSrcRGB = uint32(getInteger(gl.BLEND_SRC_RGB))
+	s.BlendDstRGB = uint32(getInteger(gl.BLEND_DST_RGB))
+	s.BlendSrcAlpha = uint32(getInteger(gl.BLEND_SRC_ALPHA))
+	s.BlendDstAlpha = uint32(getInteger(gl.BLEND_DST_ALPHA))
+	s.BlendEquationRGB = uint32(getInteger(gl.BLEND_EQUATION_RGB))
+	s.BlendEquationAlpha = uint32(getInteger(gl.BLEND_EQUATION_ALPHA))
+	s.CullFaceMode = uint32(getInteger(gl.CULL_FACE_MODE))
+	s.FrontFace = uint32(getInteger(gl.FRONT_FACE))
+	s.DepthFunc = uint32(getInteger(gl.DEPTH_FUNC))
+	gl.GetBooleanv(gl.DEPTH_WRITEMASK, &s.DepthWriteMask)
+	gl.GetBooleanv(gl.COLOR_WRITEMASK, &s.ColorWriteMask[0])
+	gl.GetIntegerv(gl.VIEWPORT, &s.Viewport[0])
+	gl.GetIntegerv(gl.SCISSOR_BOX, &s.ScissorBox[0])
+	s.Program = uint32(getInteger(gl.CURRENT_PROGRAM))
+	s.VertexArray = uint32(getInteger(gl.VERTEX_ARRAY_BINDING))
+	s.ArrayBuffer = uint32(getInteger(gl.ARRAY_BUFFER_BINDING))
+	s.ElementArrayBuffer = uint32(getInteger(gl.ELEMENT_ARRAY_BUFFER_BINDING))
+	s.DrawFramebuffer = uint32(getInteger(gl.DRAW_FRAMEBUFFER_BINDING))
+	s.ReadFramebuffer = uint32(getInteger(gl.READ_FRAMEBUFFER_BINDING))
+	s.ActiveTexture = uint32(getInteger(gl.ACTIVE_TEXTURE))
+	s.TextureBinding2D = uint32(getInteger(gl.TEXTURE_BINDING_2D))
+	s.UniformBuffer = uint32(getInteger(gl.UNIFORM_BUFFER_BINDING))
+	s.PixelUnpackBuffer = uint32(getInteger(gl.PIXEL_UNPACK_BUFFER_BINDING))
+	s.PixelPackBuffer = uint32(getInteger(gl.PIXEL_PACK_BUFFER_BINDING))
+	s.RenderbufferBinding = uint32(getInteger(gl.RENDERBUFFER_BINDING))
+	return s
+}
+
+// Diff returns a description of every field that differs between s and other,
+// such as "DepthTest: true != false", in field order. It returns nil if the
+// snapshots are equal.
+func (s State) Diff(other State) []string {
+	var diffs []string
+	a, b := reflect.ValueOf(s), reflect.ValueOf(other)
+	for i := 0; i < a.NumField(); i++ {
+		x, y := a.Field(i).Interface(), b.Field(i).Interface()
+		if x != y {
+			diffs = append(diffs, fmt.Sprintf("%s: %v != %v", a.Type().Field(i).Name, x, y))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,30 @@
+package glutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestCaptureState(t *testing.T) {
+	defer testContext(t)()
+	before := CaptureState()
+	if diff := before.Diff(CaptureState()); diff != nil {
+		t.Errorf("consecutive snapshots differ: %v", diff)
+	}
+
+	gl.Enable(gl.DEPTH_TEST)
+	after := CaptureState()
+	gl.Disable(gl.DEPTH_TEST)
+	if diff, want := before.Diff(after), []string{"DepthTest: false != true"}; !reflect.DeepEqual(diff, want) {
+		t.Errorf("Diff() = %q, want %q", diff, want)
+	}
+	if after == before {
+		t.Error("snapshots with different state compare equal")
+	}
+	if CaptureState() != before {
+		t.Error("snapshot after restoring the state differs from the original")
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,303 @@
+package glutil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// DebugMessage is a message generated by OpenGL debug output.
+type DebugMessage struct {
+	Source   uint32 // e.g. gl.DEBUG_SOURCE_API.
+	Type     uint32 // e.g. gl.DEBUG_TYPE_ERROR.
+	ID       uint32
+	Severity uint32 // e.g. gl.DEBUG_SEVERITY_HIGH.
+	Message  string
+}
+
+func (m DebugMessage) String() string {
+	return fmt.Sprintf("GL debug message %d (source 0x%X, type 0x%X, severity 0x%X): %s", m.ID, m.Source, m.Type, m.Severity, m.Message)
+}
+
+var (
+	debugCallback       func(DebugMessage)
+	fatalOnHigh         bool
+	highSeverityHandler func(DebugMessage)
+	// highSeverityMessages are the high severity messages recorded for
+	// CheckDebug.
+	highSeverityMessages []DebugMessage
+
+	// callbackInstalled reports whether dispatchDebugMessage is installed, and
+	// prevSynchronous whether synchronous debug output was enabled before it
+	// was.
+	callbackInstalled bool
+	prevSynchronous   bool
+)
+
+// maxHighSeverityMessages bounds the number of high severity messages recorded
+// for CheckDebug, in case it is never called.
+const maxHighSeverityMessages = 64
+
+// debugOutputSupported reports whether the context supports debug output,
+// which is core in OpenGL 4.3.
+func debugOutputSupported() bool {
+	return versionAtLeast(4, 3) || extensionSupported("GL_KHR_debug")
+}
+
+// dispatchDebugMessage is the callback installed with glDebugMessageCallback.
+func dispatchDebugMessage(source, gltype, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
+	m := DebugMessage{Source: source, Type: gltype, ID: id, Severity: severity, Message: message}
+	if debugCallback != nil {
+		debugCallback(m)
+	}
+	if fatalOnHigh && severity == gl.DEBUG_SEVERITY_HIGH {
+		// This runs inside the OpenGL call, called from driver frames that a
+		// panic must not unwind, so the message is only recorded by default.
+		if highSeverityHandler != nil {
+			highSeverityHandler(m)
+		} else if len(highSeverityMessages) < maxHighSeverityMessages {
+			highSeverityMessages = append(highSeverityMessages, m)
+		}
+	}
+}
+
+// installDebugCallback enables synchronous debug output on the current context
+// and installs the package's callback.
+func installDebugCallback() error {
+	if !debugOutputSupported() {
+		return requireVersion("glDebugMessageCallback", 4, 3)
+	}
+	if !callbackInstalled {
+		prevSynchronous = gl.IsEnabled(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+		callbackInstalled = true
+	}
+	gl.Enable(gl.DEBUG_OUTPUT)
+	gl.Enable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+	gl.DebugMessageCallback(dispatchDebugMessage, nil)
+	return nil
+}
+
+// uninstallDebugCallback removes the package's callback once no helper needs
+// it any more, so that messages are logged for GetDebugMessages again, and
+// restores the synchronous debug output state from before it was installed.
+func uninstallDebugCallback() {
+	if !callbackInstalled || debugCallback != nil || fatalOnHigh {
+		return
+	}
+	gl.RemoveDebugMessageCallback()
+	setEnabled(gl.DEBUG_OUTPUT_SYNCHRONOUS, prevSynchronous)
+	callbackInstalled = false
+}
+
+// SetDebugCallback enables debug output and calls fn for every debug message.
+// Passing nil removes a previously set callback; unless FatalOnHighSeverity is
+// enabled, the OpenGL callback is then uninstalled and synchronous debug
+// output restored to its state from before the callback was installed.
+//
+// The messages are delivered through a single glDebugMessageCallback shared by
+// all debug helpers of this package, so installing a different callback with
+// gl.DebugMessageCallback directly disables them. Synchronous debug output is
+// enabled as well, see SetSynchronousDebug. Debug output requires OpenGL 4.3
+// or GL_KHR_debug.
+func SetDebugCallback(fn func(DebugMessage)) error {
+	if fn == nil {
+		debugCallback = nil
+		uninstallDebugCallback()
+		return nil
+	}
+	if err := installDebugCallback(); err != nil {
+		return err
+	}
+	debugCallback = fn
+	return nil
+}
+
+// FatalOnHighSeverity makes debug messages of gl.DEBUG_SEVERITY_HIGH severity
+// fatal during development: they are recorded, and CheckDebug returns them as
+// an error once the OpenGL call causing them has returned, turning GPU misuse
+// into an immediate, diagnosable failure. Any callback set with
+// SetDebugCallback still receives the message first. Disabling it uninstalls
+// the OpenGL callback unless a callback is set, see SetDebugCallback.
+func FatalOnHighSeverity(enabled bool) error {
+	if enabled {
+		if err := installDebugCallback(); err != nil {
+			return err
+		}
+	}
+	fatalOnHigh = enabled
+	uninstallDebugCallback()
+	return nil
+}
+
+// SetHighSeverityHandler makes high severity messages call fn instead of being
+// recorded for CheckDebug while FatalOnHighSeverity is enabled. Passing nil
+// restores the default of recording them. Since fn is called from
+// glDebugMessageCallback during the OpenGL call causing the message, it must
+// not panic; a panic cannot safely unwind through the driver.
+func SetHighSeverityHandler(fn func(DebugMessage)) {
+	highSeverityHandler = fn
+}
+
+// CheckDebug returns an error holding the high severity messages recorded
+// while FatalOnHighSeverity is enabled, see SetHighSeverityHandler, and
+// forgets them. It returns nil if there are none. Calling it regularly, such
+// as once per frame, and panicking on error crashes close to the offending
+// call:
+//
+//	if err := glutil.CheckDebug(); err != nil {
+//		panic(err)
+//	}
+func CheckDebug() error {
+	if len(highSeverityMessages) == 0 {
+		return nil
+	}
+	messages := make([]string, len(highSeverityMessages))
+	for i, m := range highSeverityMessages {
+		messages[i] = m.String()
+	}
+	highSeverityMessages = nil
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// GetDebugMessages removes up to max messages from the debug message log and
+// returns them, oldest first. If max is zero or negative, all logged messages
+// are returned. Messages are only logged while debug output is enabled and no
+// callback is installed; passing nil to SetDebugCallback and disabling
+// FatalOnHighSeverity uninstalls the package's callback. The log drops new
+// messages once gl.MAX_DEBUG_LOGGED_MESSAGES are pending.
+//
+// GetDebugMessages returns nil if debug output is not supported.
+func GetDebugMessages(max int) []DebugMessage {
+	if !debugOutputSupported() {
+		return nil
+	}
+	if max <= 0 {
+		max = int(getInteger(gl.DEBUG_LOGGED_MESSAGES))
+	}
+	if max <= 0 {
+		return nil
+	}
+	var (
+		sources    = make([]uint32, max)
+		types      = make([]uint32, max)
+		ids        = make([]uint32, max)
+		severities = make([]uint32, max)
+		lengths    = make([]int32, max)
+		buf        = make([]uint8, max*int(getInteger(gl.MAX_DEBUG_MESSAGE_LENGTH)))
+	)
+	n := gl.GetDebugMessageLog(uint32(max), int32(len(buf)), &sources[0], &types[0], &ids[0], &severities[0], &lengths[0], &buf[0])
+	messages := make([]DebugMessage, n)
+	offset := 0
+	for i := range messages {
+		// Each message is stored null-terminated, and its length includes the
+		// terminator.
+		text := buf[offset : offset+int(lengths[i])]
+		offset += int(lengths[i])
+		messages[i] = DebugMessage{
+			Source:   sources[i],
+			Type:     types[i],
+			ID:       ids[i],
+			Severity: severities[i],
+			Message:  strings.TrimRight(string(text), "\x00"),
+		}
+	}
+	return messages
+}
+
+// InsertDebugMessage inserts an application message into the debug output
+// stream, for example to annotate GPU captures. The message has
+// gl.DEBUG_SOURCE_APPLICATION as its source and gl.DEBUG_TYPE_OTHER as its
+// type, and is truncated if it is longer than gl.MAX_DEBUG_MESSAGE_LENGTH
+// allows. It is a no-op if debug output is not supported.
+func InsertDebugMessage(severity uint32, id uint32, message string) {
+	if !debugOutputSupported() {
+		return
+	}
+	if max := int(getInteger(gl.MAX_DEBUG_MESSAGE_LENGTH)) - 1; len(message) > max {
+		message = message[:max]
+	}
+	gl.DebugMessageInsert(gl.DEBUG_SOURCE_APPLICATION, gl.DEBUG_TYPE_OTHER, id, severity, int32(len(message)), gl.Str(message+"\x00"))
+}
+
+// SetDebugFilter enables or disables debug messages matching the given source,
+// type, and severity, any of which may be gl.DONT_CARE to match all values.
+// For example, SetDebugFilter(gl.DEBUG_SOURCE_SHADER_COMPILER, gl.DONT_CARE,
+// gl.DONT_CARE, false) silences shader compiler messages. Filters apply to
+// both the callback and the message log. It is a no-op if debug output is not
+// supported.
+func SetDebugFilter(source, gtype, severity uint32, enabled bool) {
+	if !debugOutputSupported() {
+		return
+	}
+	gl.DebugMessageControl(source, gtype, severity, 0, nil, enabled)
+}
+
+// MuteDebugSeverity disables all debug messages of the given severity, such as
+// gl.DEBUG_SEVERITY_NOTIFICATION, which some drivers emit for every buffer
+// allocation.
+func MuteDebugSeverity(severity uint32) {
+	SetDebugFilter(gl.DONT_CARE, gl.DONT_CARE, severity, false)
+}
+
+// SetSynchronousDebug enables or disables synchronous debug output. When
+// enabled, debug messages are delivered during the OpenGL call causing them,
+// so a stack trace taken in the callback points at the offending call. This
+// may slow rendering down, so it can be disabled once debugging is done.
+// Installing a callback with SetDebugCallback or FatalOnHighSeverity enables
+// it. It is a no-op if debug output is not supported.
+func SetSynchronousDebug(enabled bool) {
+	if !debugOutputSupported() {
+		return
+	}
+	setEnabled(gl.DEBUG_OUTPUT_SYNCHRONOUS, enabled)
+}
+
+// SynchronousDebugEnabled reports whether synchronous debug output is enabled.
+func SynchronousDebugEnabled() bool {
+	return debugOutputSupported() && gl.IsEnabled(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+}
+
+// droppedDebugMessages counts the messages DebugMessageChannel has dropped
+// since the channel was created.
+var droppedDebugMessages int
+
+// DebugMessageChannel enables debug output like SetDebugCallback and returns a
+// channel receiving every debug message, for handling by a logging goroutine.
+// Messages are delivered on the thread making OpenGL calls, so when the
+// channel's buffer is full, a non-blocking channel drops the message rather
+// than stalling rendering, and DroppedDebugMessages reports how many were
+// lost. A blocking channel instead blocks the OpenGL thread until the message
+// is received, so none are lost, but rendering stalls if the receiving
+// goroutine does not keep up. It returns nil if debug output is not supported.
+//
+// The channel replaces any callback set with SetDebugCallback, and setting a
+// different callback stops messages from being forwarded to the channel. The
+// channel is never closed.
+func DebugMessageChannel(buffer int, blocking bool) <-chan DebugMessage {
+	ch := make(chan DebugMessage, buffer)
+	send := func(m DebugMessage) {
+		select {
+		case ch <- m:
+		default:
+			droppedDebugMessages++
+		}
+	}
+	if blocking {
+		send = func(m DebugMessage) { ch <- m }
+	}
+	droppedDebugMessages = 0
+	if err := SetDebugCallback(send); err != nil {
+		return nil
+	}
+	return ch
+}
+
+// DroppedDebugMessages returns the number of messages the most recent
+// non-blocking DebugMessageChannel has dropped because its buffer was full.
+// Like other OpenGL state, it must be read on the thread making OpenGL calls.
+func DroppedDebugMessages() int {
+	return droppedDebugMessages
+}
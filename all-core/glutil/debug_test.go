@@ -0,0 +1,259 @@
+package glutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// requireDebugOutput skips the test if debug output is not supported, and
+// otherwise enables it and returns a function resetting all debug state.
+func requireDebugOutput(t *testing.T) (reset func()) {
+	t.Helper()
+	if !debugOutputSupported() {
+		t.Skip("requires OpenGL 4.3 or GL_KHR_debug")
+	}
+	gl.Enable(gl.DEBUG_OUTPUT)
+	return func() {
+		FatalOnHighSeverity(false)
+		SetHighSeverityHandler(nil)
+		CheckDebug()
+		SetDebugCallback(nil)
+		SetDebugFilter(gl.DONT_CARE, gl.DONT_CARE, gl.DONT_CARE, true)
+		gl.Disable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+		gl.Disable(gl.DEBUG_OUTPUT)
+		GetDebugMessages(0)
+	}
+}
+
+func TestFatalOnHighSeverity(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+
+	var chained, handled []DebugMessage
+	if err := SetDebugCallback(func(m DebugMessage) { chained = append(chained, m) }); err != nil {
+		t.Fatal(err)
+	}
+	SetHighSeverityHandler(func(m DebugMessage) { handled = append(handled, m) })
+	if err := FatalOnHighSeverity(true); err != nil {
+		t.Fatal(err)
+	}
+
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 1, "medium")
+	InsertDebugMessage(gl.DEBUG_SEVERITY_HIGH, 2, "high")
+	if len(handled) != 1 || handled[0].ID != 2 || handled[0].Message != "high" {
+		t.Errorf("high severity handler received %v, want only message 2", handled)
+	}
+	if len(chained) != 2 {
+		t.Errorf("debug callback received %v, want both messages", chained)
+	}
+
+	checkErrors(t)
+}
+
+func TestCheckDebug(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+	if err := FatalOnHighSeverity(true); err != nil {
+		t.Fatal(err)
+	}
+
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 1, "medium")
+	if err := CheckDebug(); err != nil {
+		t.Errorf("CheckDebug() after a medium severity message = %v, want nil", err)
+	}
+	InsertDebugMessage(gl.DEBUG_SEVERITY_HIGH, 2, "first")
+	InsertDebugMessage(gl.DEBUG_SEVERITY_HIGH, 3, "second")
+	err := CheckDebug()
+	if err == nil || !strings.Contains(err.Error(), "first") || !strings.Contains(err.Error(), "second") {
+		t.Errorf("CheckDebug() = %v, want both high severity messages", err)
+	}
+	if err := CheckDebug(); err != nil {
+		t.Errorf("second CheckDebug() = %v, want nil", err)
+	}
+	checkErrors(t)
+}
+
+func TestGetDebugMessages(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+	GetDebugMessages(0)
+
+	for i, text := range []string{"first", "second"} {
+		gl.DebugMessageInsert(gl.DEBUG_SOURCE_THIRD_PARTY, gl.DEBUG_TYPE_MARKER, uint32(i+1), gl.DEBUG_SEVERITY_MEDIUM, -1, gl.Str(text+"\x00"))
+	}
+	first := GetDebugMessages(1)
+	want := DebugMessage{
+		Source:   gl.DEBUG_SOURCE_THIRD_PARTY,
+		Type:     gl.DEBUG_TYPE_MARKER,
+		ID:       1,
+		Severity: gl.DEBUG_SEVERITY_MEDIUM,
+		Message:  "first",
+	}
+	if len(first) != 1 || first[0] != want {
+		t.Errorf("GetDebugMessages(1) = %v, want [%v]", first, want)
+	}
+	rest := GetDebugMessages(0)
+	if len(rest) != 1 || rest[0].ID != 2 || rest[0].Message != "second" {
+		t.Errorf("GetDebugMessages(0) = %v, want only message 2", rest)
+	}
+	if m := GetDebugMessages(0); m != nil {
+		t.Errorf("GetDebugMessages(0) of an empty log = %v, want nil", m)
+	}
+	checkErrors(t)
+}
+
+func TestInsertDebugMessage(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+	GetDebugMessages(0)
+
+	InsertDebugMessage(gl.DEBUG_SEVERITY_NOTIFICATION, 7, "marker")
+	long := strings.Repeat("x", int(getInteger(gl.MAX_DEBUG_MESSAGE_LENGTH)))
+	InsertDebugMessage(gl.DEBUG_SEVERITY_NOTIFICATION, 8, long)
+	checkErrors(t)
+	messages := GetDebugMessages(0)
+	want := DebugMessage{
+		Source:   gl.DEBUG_SOURCE_APPLICATION,
+		Type:     gl.DEBUG_TYPE_OTHER,
+		ID:       7,
+		Severity: gl.DEBUG_SEVERITY_NOTIFICATION,
+		Message:  "marker",
+	}
+	if len(messages) != 2 || messages[0] != want {
+		t.Fatalf("logged messages = %v, want %v and a truncated message", messages, want)
+	}
+	if got, want := len(messages[1].Message), len(long)-1; got != want {
+		t.Errorf("overlong message logged with length %d, want %d", got, want)
+	}
+}
+
+func TestMuteDebugSeverity(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+	GetDebugMessages(0)
+
+	MuteDebugSeverity(gl.DEBUG_SEVERITY_NOTIFICATION)
+	InsertDebugMessage(gl.DEBUG_SEVERITY_NOTIFICATION, 1, "muted")
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 2, "kept")
+	messages := GetDebugMessages(0)
+	if len(messages) != 1 || messages[0].ID != 2 {
+		t.Errorf("logged messages = %v, want only message 2", messages)
+	}
+
+	SetDebugFilter(gl.DONT_CARE, gl.DONT_CARE, gl.DEBUG_SEVERITY_NOTIFICATION, true)
+	InsertDebugMessage(gl.DEBUG_SEVERITY_NOTIFICATION, 3, "unmuted")
+	if messages := GetDebugMessages(0); len(messages) != 1 || messages[0].ID != 3 {
+		t.Errorf("logged messages after unmuting = %v, want only message 3", messages)
+	}
+	checkErrors(t)
+}
+
+func TestSetSynchronousDebug(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+
+	for _, enabled := range []bool{true, false} {
+		SetSynchronousDebug(enabled)
+		if got := gl.IsEnabled(gl.DEBUG_OUTPUT_SYNCHRONOUS); got != enabled {
+			t.Errorf("after SetSynchronousDebug(%v), DEBUG_OUTPUT_SYNCHRONOUS is %v", enabled, got)
+		}
+		if got := SynchronousDebugEnabled(); got != enabled {
+			t.Errorf("after SetSynchronousDebug(%v), SynchronousDebugEnabled() = %v", enabled, got)
+		}
+	}
+	if err := SetDebugCallback(func(DebugMessage) {}); err != nil {
+		t.Fatal(err)
+	}
+	if !SynchronousDebugEnabled() {
+		t.Error("SetDebugCallback did not enable synchronous debug output")
+	}
+	checkErrors(t)
+}
+
+func TestDebugMessageChannel(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+
+	ch := DebugMessageChannel(1, false)
+	if ch == nil {
+		t.Fatal("DebugMessageChannel returned nil")
+	}
+	// Synchronous delivery puts the message on the channel before
+	// InsertDebugMessage returns, and the second one is dropped since the
+	// buffer is full.
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 1, "first")
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 2, "dropped")
+	select {
+	case m := <-ch:
+		if m.ID != 1 || m.Message != "first" {
+			t.Errorf("received %v, want message 1", m)
+		}
+	default:
+		t.Fatal("no message received")
+	}
+	select {
+	case m := <-ch:
+		t.Errorf("received %v, want it dropped", m)
+	default:
+	}
+	if n := DroppedDebugMessages(); n != 1 {
+		t.Errorf("DroppedDebugMessages() = %d, want 1", n)
+	}
+	checkErrors(t)
+}
+
+func TestDebugMessageChannelBlocking(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+
+	ch := DebugMessageChannel(1, true)
+	if ch == nil {
+		t.Fatal("DebugMessageChannel returned nil")
+	}
+	// The second message blocks InsertDebugMessage until it is received.
+	received := make(chan []uint32)
+	go func() {
+		var ids []uint32
+		for i := 0; i < 2; i++ {
+			ids = append(ids, (<-ch).ID)
+		}
+		received <- ids
+	}()
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 1, "first")
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 2, "second")
+	if ids := <-received; len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Errorf("received messages %v, want 1 and 2", ids)
+	}
+	if n := DroppedDebugMessages(); n != 0 {
+		t.Errorf("DroppedDebugMessages() = %d, want 0", n)
+	}
+	checkErrors(t)
+}
+
+func TestSetDebugCallbackNil(t *testing.T) {
+	defer testContext(t)()
+	defer requireDebugOutput(t)()
+	GetDebugMessages(0)
+
+	var received []DebugMessage
+	if err := SetDebugCallback(func(m DebugMessage) { received = append(received, m) }); err != nil {
+		t.Fatal(err)
+	}
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 1, "callback")
+	if err := SetDebugCallback(nil); err != nil {
+		t.Fatal(err)
+	}
+	if SynchronousDebugEnabled() {
+		t.Error("removing the callback left synchronous debug output enabled")
+	}
+	InsertDebugMessage(gl.DEBUG_SEVERITY_MEDIUM, 2, "log")
+	if len(received) != 1 || received[0].ID != 1 {
+		t.Errorf("callback received %v, want only message 1", received)
+	}
+	if messages := GetDebugMessages(0); len(messages) != 1 || messages[0].ID != 2 {
+		t.Errorf("logged messages = %v, want only message 2", messages)
+	}
+	checkErrors(t)
+}
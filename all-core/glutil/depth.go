@@ -0,0 +1,66 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// SetClipControl sets the clip space origin, gl.LOWER_LEFT or gl.UPPER_LEFT,
+// and depth mode, gl.NEGATIVE_ONE_TO_ONE or gl.ZERO_TO_ONE. It requires OpenGL
+// 4.5 or GL_ARB_clip_control.
+func SetClipControl(origin, depth uint32) error {
+	if !clipControlSupported() {
+		return requireVersion("glClipControl", 4, 5)
+	}
+	gl.ClipControl(origin, depth)
+	return nil
+}
+
+// clipControlSupported reports whether the context supports glClipControl,
+// which is core in OpenGL 4.5.
+func clipControlSupported() bool {
+	return versionAtLeast(4, 5) || extensionSupported("GL_ARB_clip_control")
+}
+
+// UseZeroToOneDepth maps clip space depth to [0, 1] instead of the default
+// [-1, 1], matching Direct3D and Vulkan conventions and improving depth
+// precision.
+func UseZeroToOneDepth() error {
+	return SetClipControl(gl.LOWER_LEFT, gl.ZERO_TO_ONE)
+}
+
+// UseReverseZ enables a [0, 1] clip space depth range and sets up the depth
+// test for reversed depth, where the near plane maps to 1 and the far plane to
+// 0: depth is cleared to 0 and the depth function is gl.GREATER. Projection
+// matrices must be built for reversed depth as well.
+//
+// The depth test is set up even if clip control is not supported and an
+// error is returned; see ConfigureReverseZ for when that is good enough.
+func UseReverseZ() error {
+	gl.ClearDepth(0)
+	gl.DepthFunc(gl.GREATER)
+	return UseZeroToOneDepth()
+}
+
+// SetDepthRange sets the mapping of normalized device depth to window depth,
+// which is [0, 1] by default.
+func SetDepthRange(near, far float64) {
+	gl.DepthRange(near, far)
+}
+
+// ConfigureReverseZ sets up reversed depth like UseReverseZ, but falls back to
+// the default [-1, 1] clip space depth range instead of failing where clip
+// control is not supported. Reversed depth still works in that case, as long
+// as the projection matrix maps the near plane to 1 and the far plane to -1,
+// but most of its precision advantage is lost. The depth range is reset to
+// [0, 1].
+//
+// ConfigureReverseZ reports whether the [0, 1] clip space depth range is in
+// use, so that the matching projection matrix can be chosen.
+func ConfigureReverseZ() (zeroToOne bool) {
+	SetDepthRange(0, 1)
+	if !clipControlSupported() {
+		gl.ClearDepth(0)
+		gl.DepthFunc(gl.GREATER)
+		return false
+	}
+	// Clip control is supported, so UseReverseZ cannot fail.
+	return UseReverseZ() == nil
+}
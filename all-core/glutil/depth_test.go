@@ -0,0 +1,74 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSetClipControl(t *testing.T) {
+	defer testContext(t)()
+	if !versionAtLeast(4, 5) && !extensionSupported("GL_ARB_clip_control") {
+		if err := UseZeroToOneDepth(); err == nil {
+			t.Error("UseZeroToOneDepth succeeded without clip control support")
+		}
+		return
+	}
+	defer SetClipControl(gl.LOWER_LEFT, gl.NEGATIVE_ONE_TO_ONE)
+
+	if err := SetClipControl(gl.UPPER_LEFT, gl.ZERO_TO_ONE); err != nil {
+		t.Fatal(err)
+	}
+	if origin, mode := getInteger(gl.CLIP_ORIGIN), getInteger(gl.CLIP_DEPTH_MODE); origin != gl.UPPER_LEFT || mode != gl.ZERO_TO_ONE {
+		t.Errorf("CLIP_ORIGIN, CLIP_DEPTH_MODE = 0x%X, 0x%X; want UPPER_LEFT, ZERO_TO_ONE", origin, mode)
+	}
+	if err := UseZeroToOneDepth(); err != nil {
+		t.Fatal(err)
+	}
+	if origin, mode := getInteger(gl.CLIP_ORIGIN), getInteger(gl.CLIP_DEPTH_MODE); origin != gl.LOWER_LEFT || mode != gl.ZERO_TO_ONE {
+		t.Errorf("CLIP_ORIGIN, CLIP_DEPTH_MODE = 0x%X, 0x%X after UseZeroToOneDepth; want LOWER_LEFT, ZERO_TO_ONE", origin, mode)
+	}
+	checkErrors(t)
+}
+
+func TestConfigureReverseZ(t *testing.T) {
+	defer testContext(t)()
+	defer func() {
+		SetClipControl(gl.LOWER_LEFT, gl.NEGATIVE_ONE_TO_ONE)
+		SetDepthRange(0, 1)
+		gl.ClearDepth(1)
+		gl.DepthFunc(gl.LESS)
+	}()
+
+	SetDepthRange(0.25, 0.75)
+	var depthRange [2]float64
+	gl.GetDoublev(gl.DEPTH_RANGE, &depthRange[0])
+	if depthRange != [2]float64{0.25, 0.75} {
+		t.Errorf("DEPTH_RANGE = %v after SetDepthRange(0.25, 0.75)", depthRange)
+	}
+
+	zeroToOne := ConfigureReverseZ()
+	gl.GetDoublev(gl.DEPTH_RANGE, &depthRange[0])
+	if depthRange != [2]float64{0, 1} {
+		t.Errorf("DEPTH_RANGE = %v after ConfigureReverseZ, want [0 1]", depthRange)
+	}
+	var clearDepth float64
+	gl.GetDoublev(gl.DEPTH_CLEAR_VALUE, &clearDepth)
+	if clearDepth != 0 {
+		t.Errorf("DEPTH_CLEAR_VALUE = %v, want 0", clearDepth)
+	}
+	if fn := getInteger(gl.DEPTH_FUNC); fn != gl.GREATER {
+		t.Errorf("DEPTH_FUNC = 0x%X, want GREATER", fn)
+	}
+	wantMode := int32(gl.NEGATIVE_ONE_TO_ONE)
+	if versionAtLeast(4, 5) || extensionSupported("GL_ARB_clip_control") {
+		wantMode = gl.ZERO_TO_ONE
+	}
+	if zeroToOne != (wantMode == gl.ZERO_TO_ONE) {
+		t.Errorf("ConfigureReverseZ() = %v with CLIP_DEPTH_MODE 0x%X", zeroToOne, wantMode)
+	}
+	if mode := getInteger(gl.CLIP_DEPTH_MODE); mode != wantMode {
+		t.Errorf("CLIP_DEPTH_MODE = 0x%X, want 0x%X", mode, wantMode)
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,13 @@
+// Package glutil provides convenience helpers built on top of the all-core
+// OpenGL bindings.
+//
+// The helpers call into github.com/go-gl/gl/all-core/gl, so gl.Init must have
+// succeeded under an active OpenGL context before any of them are used, and
+// they are subject to the same threading restrictions as the bindings
+// themselves.
+//
+// The all-core bindings load every function leniently, meaning a function
+// that the driver does not expose is simply left unset. Helpers that depend on
+// a particular OpenGL version or extension check for it first and report the
+// missing feature instead of calling into a function that was never loaded.
+package glutil
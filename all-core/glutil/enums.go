@@ -0,0 +1,130 @@
+package glutil
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// BitName associates a single bit of a GL bitfield with its enum name.
+type BitName struct {
+	Bit  uint32
+	Name string
+}
+
+// Bitfield lists the named bits of one kind of GL bitfield. Bit values are
+// reused between unrelated bitfields, so a value can only be decoded once it is
+// known which kind of bitfield it belongs to.
+type Bitfield []BitName
+
+// Named bits of commonly logged GL bitfields.
+var (
+	// ClearBufferBits are the buffer bits accepted by glClear.
+	ClearBufferBits = Bitfield{
+		{gl.COLOR_BUFFER_BIT, "COLOR_BUFFER_BIT"},
+		{gl.DEPTH_BUFFER_BIT, "DEPTH_BUFFER_BIT"},
+		{gl.STENCIL_BUFFER_BIT, "STENCIL_BUFFER_BIT"},
+	}
+
+	// ContextFlagBits are the bits reported by gl.CONTEXT_FLAGS.
+	ContextFlagBits = Bitfield{
+		{gl.CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT, "CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT"},
+		{gl.CONTEXT_FLAG_DEBUG_BIT, "CONTEXT_FLAG_DEBUG_BIT"},
+		{gl.CONTEXT_FLAG_ROBUST_ACCESS_BIT, "CONTEXT_FLAG_ROBUST_ACCESS_BIT"},
+		{gl.CONTEXT_FLAG_NO_ERROR_BIT, "CONTEXT_FLAG_NO_ERROR_BIT"},
+	}
+
+	// ContextProfileBits are the bits reported by gl.CONTEXT_PROFILE_MASK.
+	ContextProfileBits = Bitfield{
+		{gl.CONTEXT_CORE_PROFILE_BIT, "CONTEXT_CORE_PROFILE_BIT"},
+		{gl.CONTEXT_COMPATIBILITY_PROFILE_BIT, "CONTEXT_COMPATIBILITY_PROFILE_BIT"},
+	}
+
+	// MapBufferAccessBits are the access bits accepted by glMapBufferRange and
+	// glBufferStorage.
+	MapBufferAccessBits = Bitfield{
+		{gl.MAP_READ_BIT, "MAP_READ_BIT"},
+		{gl.MAP_WRITE_BIT, "MAP_WRITE_BIT"},
+		{gl.MAP_INVALIDATE_RANGE_BIT, "MAP_INVALIDATE_RANGE_BIT"},
+		{gl.MAP_INVALIDATE_BUFFER_BIT, "MAP_INVALIDATE_BUFFER_BIT"},
+		{gl.MAP_FLUSH_EXPLICIT_BIT, "MAP_FLUSH_EXPLICIT_BIT"},
+		{gl.MAP_UNSYNCHRONIZED_BIT, "MAP_UNSYNCHRONIZED_BIT"},
+		{gl.MAP_PERSISTENT_BIT, "MAP_PERSISTENT_BIT"},
+		{gl.MAP_COHERENT_BIT, "MAP_COHERENT_BIT"},
+		{gl.DYNAMIC_STORAGE_BIT, "DYNAMIC_STORAGE_BIT"},
+		{gl.CLIENT_STORAGE_BIT, "CLIENT_STORAGE_BIT"},
+	}
+
+	// MemoryBarrierBits are the bits accepted by glMemoryBarrier.
+	MemoryBarrierBits = Bitfield{
+		{gl.VERTEX_ATTRIB_ARRAY_BARRIER_BIT, "VERTEX_ATTRIB_ARRAY_BARRIER_BIT"},
+		{gl.ELEMENT_ARRAY_BARRIER_BIT, "ELEMENT_ARRAY_BARRIER_BIT"},
+		{gl.UNIFORM_BARRIER_BIT, "UNIFORM_BARRIER_BIT"},
+		{gl.TEXTURE_FETCH_BARRIER_BIT, "TEXTURE_FETCH_BARRIER_BIT"},
+		{gl.SHADER_IMAGE_ACCESS_BARRIER_BIT, "SHADER_IMAGE_ACCESS_BARRIER_BIT"},
+		{gl.COMMAND_BARRIER_BIT, "COMMAND_BARRIER_BIT"},
+		{gl.PIXEL_BUFFER_BARRIER_BIT, "PIXEL_BUFFER_BARRIER_BIT"},
+		{gl.TEXTURE_UPDATE_BARRIER_BIT, "TEXTURE_UPDATE_BARRIER_BIT"},
+		{gl.BUFFER_UPDATE_BARRIER_BIT, "BUFFER_UPDATE_BARRIER_BIT"},
+		{gl.FRAMEBUFFER_BARRIER_BIT, "FRAMEBUFFER_BARRIER_BIT"},
+		{gl.TRANSFORM_FEEDBACK_BARRIER_BIT, "TRANSFORM_FEEDBACK_BARRIER_BIT"},
+		{gl.ATOMIC_COUNTER_BARRIER_BIT, "ATOMIC_COUNTER_BARRIER_BIT"},
+		{gl.SHADER_STORAGE_BARRIER_BIT, "SHADER_STORAGE_BARRIER_BIT"},
+		{gl.CLIENT_MAPPED_BUFFER_BARRIER_BIT, "CLIENT_MAPPED_BUFFER_BARRIER_BIT"},
+		{gl.QUERY_BUFFER_BARRIER_BIT, "QUERY_BUFFER_BARRIER_BIT"},
+	}
+
+	// ShaderStageBits are the stage bits accepted by glUseProgramStages.
+	ShaderStageBits = Bitfield{
+		{gl.VERTEX_SHADER_BIT, "VERTEX_SHADER_BIT"},
+		{gl.FRAGMENT_SHADER_BIT, "FRAGMENT_SHADER_BIT"},
+		{gl.GEOMETRY_SHADER_BIT, "GEOMETRY_SHADER_BIT"},
+		{gl.TESS_CONTROL_SHADER_BIT, "TESS_CONTROL_SHADER_BIT"},
+		{gl.TESS_EVALUATION_SHADER_BIT, "TESS_EVALUATION_SHADER_BIT"},
+		{gl.COMPUTE_SHADER_BIT, "COMPUTE_SHADER_BIT"},
+	}
+)
+
+// BitfieldNames returns the names of the bits set in value, in the order they
+// are listed in bits. Set bits that have no name in bits are reported as a
+// single hexadecimal value at the end. For example:
+//
+//	var flags int32
+//	gl.GetIntegerv(gl.CONTEXT_FLAGS, &flags)
+//	log.Println(glutil.BitfieldNames(uint32(flags), glutil.ContextFlagBits))
+func BitfieldNames(value uint32, bits Bitfield) []string {
+	var names []string
+	remaining := value
+	for _, b := range bits {
+		if b.Bit != 0 && value&b.Bit == b.Bit {
+			names = append(names, b.Name)
+			remaining &^= b.Bit
+		}
+	}
+	if remaining != 0 {
+		names = append(names, fmt.Sprintf("0x%X", remaining))
+	}
+	return names
+}
+
+// errorNames maps the error codes returned by glGetError to their names.
+var errorNames = map[uint32]string{
+	gl.NO_ERROR:                      "NO_ERROR",
+	gl.INVALID_ENUM:                  "INVALID_ENUM",
+	gl.INVALID_VALUE:                 "INVALID_VALUE",
+	gl.INVALID_OPERATION:             "INVALID_OPERATION",
+	gl.STACK_OVERFLOW:                "STACK_OVERFLOW",
+	gl.STACK_UNDERFLOW:               "STACK_UNDERFLOW",
+	gl.OUT_OF_MEMORY:                 "OUT_OF_MEMORY",
+	gl.INVALID_FRAMEBUFFER_OPERATION: "INVALID_FRAMEBUFFER_OPERATION",
+	gl.CONTEXT_LOST:                  "CONTEXT_LOST",
+}
+
+// ErrorName returns the name of an error code returned by glGetError, such as
+// "INVALID_ENUM", or its hexadecimal value if it is unknown.
+func ErrorName(code uint32) string {
+	if name, ok := errorNames[code]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%X", code)
+}
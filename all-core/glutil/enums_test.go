@@ -0,0 +1,40 @@
+package glutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestBitfieldNames(t *testing.T) {
+	tests := []struct {
+		value uint32
+		bits  Bitfield
+		want  []string
+	}{
+		{0, ClearBufferBits, nil},
+		{gl.DEPTH_BUFFER_BIT, ClearBufferBits, []string{"DEPTH_BUFFER_BIT"}},
+		{
+			gl.STENCIL_BUFFER_BIT | gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT,
+			ClearBufferBits,
+			[]string{"COLOR_BUFFER_BIT", "DEPTH_BUFFER_BIT", "STENCIL_BUFFER_BIT"},
+		},
+		{0x3, ClearBufferBits, []string{"0x3"}},
+		{gl.COLOR_BUFFER_BIT | 0x10001, ClearBufferBits, []string{"COLOR_BUFFER_BIT", "0x10001"}},
+		{gl.MAP_READ_BIT | gl.MAP_PERSISTENT_BIT | gl.MAP_COHERENT_BIT, MapBufferAccessBits,
+			[]string{"MAP_READ_BIT", "MAP_PERSISTENT_BIT", "MAP_COHERENT_BIT"}},
+		{gl.CONTEXT_CORE_PROFILE_BIT, ContextProfileBits, []string{"CONTEXT_CORE_PROFILE_BIT"}},
+		{gl.ALL_SHADER_BITS, ShaderStageBits, []string{
+			"VERTEX_SHADER_BIT", "FRAGMENT_SHADER_BIT", "GEOMETRY_SHADER_BIT",
+			"TESS_CONTROL_SHADER_BIT", "TESS_EVALUATION_SHADER_BIT", "COMPUTE_SHADER_BIT",
+			"0xFFFFFFC0",
+		}},
+		{gl.VERTEX_SHADER_BIT, nil, []string{"0x1"}},
+	}
+	for _, tt := range tests {
+		if got := BitfieldNames(tt.value, tt.bits); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("BitfieldNames(0x%X) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
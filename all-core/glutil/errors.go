@@ -0,0 +1,46 @@
+package glutil
+
+import (
+	"log"
+	"runtime"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// maxErrors bounds the number of errors GetErrors drains, since glGetError
+// keeps returning gl.CONTEXT_LOST once the context is lost.
+const maxErrors = 64
+
+// GetErrors drains and returns all error codes recorded by OpenGL since the
+// last call to glGetError. It returns nil if there are none.
+func GetErrors() []uint32 {
+	var errs []uint32
+	for len(errs) < maxErrors {
+		code := gl.GetError()
+		if code == gl.NO_ERROR {
+			break
+		}
+		errs = append(errs, code)
+	}
+	return errs
+}
+
+// LogErrors drains all recorded OpenGL errors and logs each of them with the
+// standard logger, along with prefix and the file and line LogErrors was
+// called from. Sprinkling calls throughout rendering code narrows down which
+// call caused an error that would otherwise only surface later.
+//
+// When there are no errors LogErrors costs a single glGetError call.
+func LogErrors(prefix string) {
+	errs := GetErrors()
+	if len(errs) == 0 {
+		return
+	}
+	_, file, line, ok := runtime.Caller(1)
+	if !ok {
+		file, line = "???", 0
+	}
+	for _, code := range errs {
+		log.Printf("%s: %s:%d: GL error %s", prefix, file, line, ErrorName(code))
+	}
+}
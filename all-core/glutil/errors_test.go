@@ -0,0 +1,58 @@
+package glutil
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestErrorName(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want string
+	}{
+		{gl.NO_ERROR, "NO_ERROR"},
+		{gl.INVALID_ENUM, "INVALID_ENUM"},
+		{gl.INVALID_VALUE, "INVALID_VALUE"},
+		{gl.INVALID_OPERATION, "INVALID_OPERATION"},
+		{gl.OUT_OF_MEMORY, "OUT_OF_MEMORY"},
+		{gl.INVALID_FRAMEBUFFER_OPERATION, "INVALID_FRAMEBUFFER_OPERATION"},
+		{gl.CONTEXT_LOST, "CONTEXT_LOST"},
+		{0x1234, "0x1234"},
+		{0xFFFFFFFF, "0xFFFFFFFF"},
+	}
+	for _, tt := range tests {
+		if got := ErrorName(tt.code); got != tt.want {
+			t.Errorf("ErrorName(0x%X) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestLogErrors(t *testing.T) {
+	defer testContext(t)()
+	GetErrors()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	LogErrors("none")
+	if buf.Len() != 0 {
+		t.Errorf("LogErrors logged %q without errors", buf.String())
+	}
+
+	gl.Enable(0xFFFF)
+	LogErrors("frame")
+	out := buf.String()
+	if !strings.Contains(out, "frame: ") || !strings.Contains(out, "errors_test.go:") ||
+		!strings.Contains(out, "GL error INVALID_ENUM") {
+		t.Errorf("LogErrors logged %q, want prefix, caller position and INVALID_ENUM", out)
+	}
+	if errs := GetErrors(); errs != nil {
+		t.Errorf("GetErrors() = %v after LogErrors, want none", errs)
+	}
+}
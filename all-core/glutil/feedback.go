@@ -0,0 +1,86 @@
+package glutil
+
+import (
+	"errors"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// SetFeedbackVaryings selects the vertex or geometry shader outputs of program
+// that transform feedback captures, interleaved into a single buffer in the
+// given order, as CaptureTransformFeedback expects. The selection only takes
+// effect the next time program is linked.
+func SetFeedbackVaryings(program uint32, names ...string) {
+	if len(names) == 0 {
+		return
+	}
+	terminated := make([]string, len(names))
+	for i, name := range names {
+		terminated[i] = name + "\x00"
+	}
+	varyings, free := gl.Strs(terminated...)
+	defer free()
+	gl.TransformFeedbackVaryings(program, int32(len(names)), varyings, gl.INTERLEAVED_ATTRIBS)
+}
+
+// CaptureTransformFeedback runs drawFn with transform feedback active and
+// rasterization disabled, and reads the captured outputs back into out, which
+// must be a slice of values laid out like the interleaved varyings, such as a
+// []float32 or a slice of structs. Exactly len(out) elements are captured, so
+// drawFn must not emit more. The current program must have been linked after
+// selecting its outputs with SetFeedbackVaryings, and drawFn must not change
+// it. Mode is the primitive type drawFn draws, gl.POINTS, gl.LINES, or
+// gl.TRIANGLES.
+//
+// This turns the vertex pipeline into a general purpose compute facility on
+// contexts without compute shaders. The previous transform feedback buffer
+// bindings and rasterizer discard state are restored on return, even if
+// drawFn panics.
+func CaptureTransformFeedback(mode uint32, drawFn func(), out interface{}) error {
+	if !SupportsTransformFeedback() {
+		return requireVersion("glBeginTransformFeedback", 3, 0)
+	}
+	ptr, size, err := sliceData(out)
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return errors.New("transform feedback output must not be empty")
+	}
+	program := uint32(getInteger(gl.CURRENT_PROGRAM))
+	var varyings int32
+	if program != 0 {
+		gl.GetProgramiv(program, gl.TRANSFORM_FEEDBACK_VARYINGS, &varyings)
+	}
+	if varyings == 0 {
+		return errors.New("current program captures no transform feedback varyings")
+	}
+
+	prev := boundBuffer(gl.TRANSFORM_FEEDBACK_BUFFER)
+	prevIndexed := uint32(GetIntegerIndexed(gl.TRANSFORM_FEEDBACK_BUFFER_BINDING, 0))
+	defer func() {
+		gl.BindBufferBase(gl.TRANSFORM_FEEDBACK_BUFFER, 0, prevIndexed)
+		gl.BindBuffer(gl.TRANSFORM_FEEDBACK_BUFFER, prev)
+	}()
+
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	defer gl.DeleteBuffers(1, &buf)
+	gl.BindBuffer(gl.TRANSFORM_FEEDBACK_BUFFER, buf)
+	gl.BufferData(gl.TRANSFORM_FEEDBACK_BUFFER, size, nil, gl.STREAM_READ)
+	gl.BindBufferBase(gl.TRANSFORM_FEEDBACK_BUFFER, 0, buf)
+
+	func() {
+		// Deferred so that a panicking drawFn does not leave transform
+		// feedback active and rasterization disabled.
+		discard := gl.IsEnabled(gl.RASTERIZER_DISCARD)
+		gl.Enable(gl.RASTERIZER_DISCARD)
+		defer setEnabled(gl.RASTERIZER_DISCARD, discard)
+		gl.BeginTransformFeedback(mode)
+		defer gl.EndTransformFeedback()
+		drawFn()
+	}()
+
+	gl.GetBufferSubData(gl.TRANSFORM_FEEDBACK_BUFFER, 0, size, ptr)
+	return nil
+}
@@ -0,0 +1,115 @@
+package glutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+const feedbackShader = `#version 330 core
+out float doubled;
+out vec2 pair;
+void main() {
+	doubled = float(gl_VertexID) * 2.0;
+	pair = vec2(gl_VertexID, -gl_VertexID);
+	gl_Position = vec4(0.0);
+}
+`
+
+// newFeedbackProgram links feedbackShader with its outputs selected for
+// capture.
+func newFeedbackProgram(t *testing.T) uint32 {
+	t.Helper()
+	shader, err := CompileShader(gl.VERTEX_SHADER, feedbackShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteShader(shader)
+	program := gl.CreateProgram()
+	gl.AttachShader(program, shader)
+	SetFeedbackVaryings(program, "doubled", "pair")
+	gl.LinkProgram(program)
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+	if status == gl.FALSE {
+		t.Fatalf("link: %s", ProgramInfoLog(program))
+	}
+	return program
+}
+
+// bindEmptyVAO binds a vertex array without attributes, which the core
+// profile requires for drawing, and returns a function deleting it.
+func bindEmptyVAO() (deleteFn func()) {
+	var vao uint32
+	gl.GenVertexArrays(1, &vao)
+	gl.BindVertexArray(vao)
+	return func() {
+		gl.BindVertexArray(0)
+		gl.DeleteVertexArrays(1, &vao)
+	}
+}
+
+func TestCaptureTransformFeedback(t *testing.T) {
+	defer testContext(t)()
+	program := newFeedbackProgram(t)
+	defer gl.DeleteProgram(program)
+	defer bindEmptyVAO()()
+
+	type output struct {
+		Doubled float32
+		Pair    [2]float32
+	}
+	out := make([]output, 3)
+	if err := CaptureTransformFeedback(gl.POINTS, func() {}, out); err == nil {
+		t.Error("CaptureTransformFeedback succeeded without a current program")
+	}
+	var err error
+	WithProgram(program, func() {
+		err = CaptureTransformFeedback(gl.POINTS, func() { gl.DrawArrays(gl.POINTS, 0, 3) }, out)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []output{{0, [2]float32{0, 0}}, {2, [2]float32{1, -1}}, {4, [2]float32{2, -2}}}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("captured %v, want %v", out, want)
+	}
+	if gl.IsEnabled(gl.RASTERIZER_DISCARD) {
+		t.Error("RASTERIZER_DISCARD is still enabled")
+	}
+	if err := CaptureTransformFeedback(gl.POINTS, func() {}, []float32{}); err == nil {
+		t.Error("CaptureTransformFeedback accepted an empty output slice")
+	}
+	if err := CaptureTransformFeedback(gl.POINTS, func() {}, output{}); err == nil {
+		t.Error("CaptureTransformFeedback accepted an output that is not a slice")
+	}
+	checkErrors(t)
+}
+
+func TestCaptureTransformFeedbackPanic(t *testing.T) {
+	defer testContext(t)()
+	program := newFeedbackProgram(t)
+	defer gl.DeleteProgram(program)
+
+	WithProgram(program, func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("panic in drawFn was not propagated")
+			}
+		}()
+		CaptureTransformFeedback(gl.POINTS, func() { panic("draw failed") }, make([]float32, 3))
+	})
+	var active bool
+	gl.GetBooleanv(gl.TRANSFORM_FEEDBACK_ACTIVE, &active)
+	if active {
+		t.Error("transform feedback is still active after drawFn panicked")
+	}
+	if gl.IsEnabled(gl.RASTERIZER_DISCARD) {
+		t.Error("RASTERIZER_DISCARD is still enabled after drawFn panicked")
+	}
+	if buf := boundBuffer(gl.TRANSFORM_FEEDBACK_BUFFER); buf != 0 {
+		t.Errorf("TRANSFORM_FEEDBACK_BUFFER binding = %d after drawFn panicked, want 0", buf)
+	}
+	checkErrors(t)
+}
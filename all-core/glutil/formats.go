@@ -0,0 +1,109 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// internalFormatQuerySupported reports whether the current context supports
+// querying internal format properties with glGetInternalformativ.
+func internalFormatQuerySupported() bool {
+	return versionAtLeast(4, 3) || extensionSupported("GL_ARB_internalformat_query2")
+}
+
+// InternalFormatParam returns a property of an internal format when used with
+// target, such as gl.COLOR_RENDERABLE for gl.RGBA8 textures of gl.TEXTURE_2D.
+// It requires OpenGL 4.3 or GL_ARB_internalformat_query2 and returns false for
+// ok on older contexts.
+func InternalFormatParam(target, internalFormat, pname uint32) (value int32, ok bool) {
+	if !internalFormatQuerySupported() {
+		return 0, false
+	}
+	gl.GetInternalformativ(target, internalFormat, pname, 1, &value)
+	return value, true
+}
+
+// IsColorRenderable reports whether 2D textures of internalFormat can be
+// attached to a framebuffer color attachment. It reports false if internal
+// format properties cannot be queried, see InternalFormatParam.
+func IsColorRenderable(internalFormat uint32) bool {
+	v, ok := InternalFormatParam(gl.TEXTURE_2D, internalFormat, gl.COLOR_RENDERABLE)
+	return ok && v == gl.TRUE
+}
+
+// IsDepthRenderable reports whether 2D textures of internalFormat can be
+// attached to a framebuffer depth attachment. It reports false if internal
+// format properties cannot be queried, see InternalFormatParam.
+func IsDepthRenderable(internalFormat uint32) bool {
+	v, ok := InternalFormatParam(gl.TEXTURE_2D, internalFormat, gl.DEPTH_RENDERABLE)
+	return ok && v == gl.TRUE
+}
+
+// IsFilterable reports whether 2D textures of internalFormat support linear
+// filtering, possibly with caveats such as reduced performance. It reports
+// false if internal format properties cannot be queried, see
+// InternalFormatParam.
+func IsFilterable(internalFormat uint32) bool {
+	v, ok := InternalFormatParam(gl.TEXTURE_2D, internalFormat, gl.FILTER)
+	return ok && (v == gl.FULL_SUPPORT || v == gl.CAVEAT_SUPPORT)
+}
+
+// SupportsFloatColorBuffer reports whether gl.RGBA16F and gl.RGBA32F textures
+// can be rendered to, as needed for HDR rendering. Desktop OpenGL requires
+// both since version 3.0, so unless internal format properties can be queried
+// the context version alone decides.
+func SupportsFloatColorBuffer() bool {
+	if !internalFormatQuerySupported() {
+		return versionAtLeast(3, 0)
+	}
+	return IsColorRenderable(gl.RGBA16F) && IsColorRenderable(gl.RGBA32F)
+}
+
+// SupportsFloatBlend reports whether blending works when rendering to
+// gl.RGBA32F color buffers. Desktop OpenGL requires it since version 3.0, so
+// unless internal format properties can be queried the context version alone
+// decides.
+func SupportsFloatBlend() bool {
+	if !internalFormatQuerySupported() {
+		return versionAtLeast(3, 0)
+	}
+	v, _ := InternalFormatParam(gl.TEXTURE_2D, gl.RGBA32F, gl.FRAMEBUFFER_BLEND)
+	return v == gl.FULL_SUPPORT || v == gl.CAVEAT_SUPPORT
+}
+
+// formatSupported reports whether 2D textures of internalFormat are supported.
+// Without internal format queries it assumes they are, since the formats
+// BestColorFormat picks from are all required by desktop OpenGL 3.0.
+func formatSupported(internalFormat uint32) bool {
+	v, ok := InternalFormatParam(gl.TEXTURE_2D, internalFormat, gl.INTERNALFORMAT_SUPPORTED)
+	return !ok || v == gl.TRUE
+}
+
+// BestColorFormat picks an internal format for color textures with the desired
+// properties that the current context supports:
+//
+//   - With wantHDR, gl.RGBA16F if float color buffers are supported, see
+//     SupportsFloatColorBuffer, or gl.R11F_G11F_B10F, which needs half the
+//     memory, if alpha is not wanted and it is color renderable. Floating
+//     point formats are linear, so wantSRGB is ignored.
+//   - With wantSRGB, gl.SRGB8_ALPHA8, or gl.SRGB8 if alpha is not wanted.
+//   - Otherwise, or if the formats above are unsupported, gl.RGBA8, or gl.RGB8
+//     if alpha is not wanted.
+func BestColorFormat(wantAlpha, wantSRGB, wantHDR bool) (internalFormat uint32) {
+	if wantHDR && SupportsFloatColorBuffer() {
+		if !wantAlpha && IsColorRenderable(gl.R11F_G11F_B10F) {
+			return gl.R11F_G11F_B10F
+		}
+		return gl.RGBA16F
+	}
+	if wantSRGB {
+		f := uint32(gl.SRGB8_ALPHA8)
+		if !wantAlpha {
+			f = gl.SRGB8
+		}
+		if formatSupported(f) {
+			return f
+		}
+	}
+	if !wantAlpha {
+		return gl.RGB8
+	}
+	return gl.RGBA8
+}
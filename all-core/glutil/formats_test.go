@@ -0,0 +1,83 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// requireInternalFormatQuery skips the test if internal format properties
+// cannot be queried.
+func requireInternalFormatQuery(t *testing.T) {
+	t.Helper()
+	if !internalFormatQuerySupported() {
+		t.Skip("requires OpenGL 4.3 or GL_ARB_internalformat_query2")
+	}
+}
+
+func TestInternalFormatProperties(t *testing.T) {
+	defer testContext(t)()
+	requireInternalFormatQuery(t)
+	for _, f := range []struct {
+		name                     string
+		format                   uint32
+		color, depth, filterable bool
+	}{
+		{"RGBA8", gl.RGBA8, true, false, true},
+		{"RGBA32UI", gl.RGBA32UI, true, false, false},
+		{"DEPTH_COMPONENT24", gl.DEPTH_COMPONENT24, false, true, true},
+	} {
+		if got := IsColorRenderable(f.format); got != f.color {
+			t.Errorf("IsColorRenderable(%s) = %v, want %v", f.name, got, f.color)
+		}
+		if got := IsDepthRenderable(f.format); got != f.depth {
+			t.Errorf("IsDepthRenderable(%s) = %v, want %v", f.name, got, f.depth)
+		}
+		if got := IsFilterable(f.format); got != f.filterable {
+			t.Errorf("IsFilterable(%s) = %v, want %v", f.name, got, f.filterable)
+		}
+	}
+	checkErrors(t)
+}
+
+func TestFloatColorBuffers(t *testing.T) {
+	defer testContext(t)()
+	requireInternalFormatQuery(t)
+	if !IsColorRenderable(gl.RGBA16F) {
+		t.Error("IsColorRenderable(RGBA16F) = false, want true")
+	}
+	if !SupportsFloatColorBuffer() {
+		t.Error("SupportsFloatColorBuffer() = false, want true")
+	}
+	if !SupportsFloatBlend() {
+		t.Error("SupportsFloatBlend() = false, want true")
+	}
+	checkErrors(t)
+}
+
+func TestBestColorFormat(t *testing.T) {
+	defer testContext(t)()
+	requireInternalFormatQuery(t)
+	hdrOpaque := uint32(gl.RGBA16F)
+	if IsColorRenderable(gl.R11F_G11F_B10F) {
+		hdrOpaque = gl.R11F_G11F_B10F
+	}
+	for _, tt := range []struct {
+		name                         string
+		wantAlpha, wantSRGB, wantHDR bool
+		format                       uint32
+	}{
+		{"alpha", true, false, false, gl.RGBA8},
+		{"opaque", false, false, false, gl.RGB8},
+		{"sRGB alpha", true, true, false, gl.SRGB8_ALPHA8},
+		{"sRGB opaque", false, true, false, gl.SRGB8},
+		{"HDR alpha", true, false, true, gl.RGBA16F},
+		{"HDR opaque", false, false, true, hdrOpaque},
+		{"HDR ignores sRGB", true, true, true, gl.RGBA16F},
+	} {
+		if got := BestColorFormat(tt.wantAlpha, tt.wantSRGB, tt.wantHDR); got != tt.format {
+			t.Errorf("%s: BestColorFormat() = 0x%X, want 0x%X", tt.name, got, tt.format)
+		}
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,207 @@
+package glutil
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// DrawBuffers selects the color attachments of the bound draw framebuffer that
+// fragment outputs are written to, in output location order. For example:
+//
+//	err := glutil.DrawBuffers(gl.COLOR_ATTACHMENT0, gl.COLOR_ATTACHMENT1)
+//
+// Calling DrawBuffers without any attachments is equivalent to DrawBufferNone.
+// If more attachments are given than MaxDrawBuffers allows, an error is
+// returned and the draw buffers are left unchanged.
+func DrawBuffers(attachments ...uint32) error {
+	if len(attachments) == 0 {
+		DrawBufferNone()
+		return nil
+	}
+	if max := MaxDrawBuffers(); len(attachments) > int(max) {
+		return fmt.Errorf("%d draw buffers requested, but at most %d are supported", len(attachments), max)
+	}
+	gl.DrawBuffers(int32(len(attachments)), &attachments[0])
+	return nil
+}
+
+// DrawBufferNone disables color output for the bound draw framebuffer, as used
+// by depth-only passes.
+func DrawBufferNone() {
+	gl.DrawBuffer(gl.NONE)
+}
+
+// AttachmentInfo describes the image attached to a framebuffer attachment
+// point, as reported by glGetFramebufferAttachmentParameteriv.
+type AttachmentInfo struct {
+	// ObjectType is gl.NONE for an unused attachment, otherwise one of
+	// gl.TEXTURE, gl.RENDERBUFFER, or gl.FRAMEBUFFER_DEFAULT. The remaining
+	// fields are zero for an unused attachment.
+	ObjectType uint32
+	// ObjectName is the texture or renderbuffer name. It is zero for the
+	// default framebuffer.
+	ObjectName uint32
+
+	// TextureLevel and TextureLayer are only set for texture attachments.
+	TextureLevel int32
+	TextureLayer int32
+
+	ComponentType uint32 // e.g. gl.UNSIGNED_NORMALIZED or gl.FLOAT.
+	ColorEncoding uint32 // gl.LINEAR or gl.SRGB.
+
+	RedBits, GreenBits, BlueBits, AlphaBits int32
+	DepthBits, StencilBits                  int32
+}
+
+// FramebufferAttachmentInfo queries all parameters of an attachment of the
+// framebuffer bound to target in one go. This is mostly useful for finding
+// out why a framebuffer is incomplete.
+func FramebufferAttachmentInfo(target, attachment uint32) AttachmentInfo {
+	param := func(pname uint32) int32 {
+		var v int32
+		gl.GetFramebufferAttachmentParameteriv(target, attachment, pname, &v)
+		return v
+	}
+	var info AttachmentInfo
+	info.ObjectType = uint32(param(gl.FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE))
+	if info.ObjectType == gl.NONE {
+		return info
+	}
+	if info.ObjectType != gl.FRAMEBUFFER_DEFAULT {
+		info.ObjectName = uint32(param(gl.FRAMEBUFFER_ATTACHMENT_OBJECT_NAME))
+	}
+	if info.ObjectType == gl.TEXTURE {
+		info.TextureLevel = param(gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL)
+		info.TextureLayer = param(gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LAYER)
+	}
+	// The component type is ambiguous for combined depth/stencil attachments
+	// and querying it is an error.
+	if attachment != gl.DEPTH_STENCIL_ATTACHMENT {
+		info.ComponentType = uint32(param(gl.FRAMEBUFFER_ATTACHMENT_COMPONENT_TYPE))
+	}
+	info.ColorEncoding = uint32(param(gl.FRAMEBUFFER_ATTACHMENT_COLOR_ENCODING))
+	info.RedBits = param(gl.FRAMEBUFFER_ATTACHMENT_RED_SIZE)
+	info.GreenBits = param(gl.FRAMEBUFFER_ATTACHMENT_GREEN_SIZE)
+	info.BlueBits = param(gl.FRAMEBUFFER_ATTACHMENT_BLUE_SIZE)
+	info.AlphaBits = param(gl.FRAMEBUFFER_ATTACHMENT_ALPHA_SIZE)
+	info.DepthBits = param(gl.FRAMEBUFFER_ATTACHMENT_DEPTH_SIZE)
+	info.StencilBits = param(gl.FRAMEBUFFER_ATTACHMENT_STENCIL_SIZE)
+	return info
+}
+
+// InvalidateFramebuffer tells the driver that the contents of the given
+// attachments of the framebuffer bound to target are no longer needed, for
+// example the depth attachment after a pass. Tiled GPUs can then skip writing
+// them back to memory.
+//
+// Invalidation is only a hint, so on contexts without OpenGL 4.3 or
+// GL_ARB_invalidate_subdata InvalidateFramebuffer does nothing.
+func InvalidateFramebuffer(target uint32, attachments ...uint32) {
+	if len(attachments) == 0 {
+		return
+	}
+	if !versionAtLeast(4, 3) && !extensionSupported("GL_ARB_invalidate_subdata") {
+		return
+	}
+	gl.InvalidateFramebuffer(target, int32(len(attachments)), &attachments[0])
+}
+
+// InvalidateDefaultDepthStencil invalidates the depth and stencil buffers of
+// the default framebuffer, which must be bound. See InvalidateFramebuffer.
+func InvalidateDefaultDepthStencil() {
+	InvalidateFramebuffer(gl.FRAMEBUFFER, gl.DEPTH, gl.STENCIL)
+}
+
+// ClearRegion clears the buffers selected by mask, such as
+// gl.COLOR_BUFFER_BIT|gl.DEPTH_BUFFER_BIT, within a rectangle of the draw
+// framebuffer with its lower left corner at x and y. The scissor test is used
+// to restrict the clear and restored afterwards.
+func ClearRegion(x, y, width, height int32, mask uint32) {
+	enabled := gl.IsEnabled(gl.SCISSOR_TEST)
+	var box [4]int32
+	gl.GetIntegerv(gl.SCISSOR_BOX, &box[0])
+
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.Scissor(x, y, width, height)
+	gl.Clear(mask)
+
+	gl.Scissor(box[0], box[1], box[2], box[3])
+	setEnabled(gl.SCISSOR_TEST, enabled)
+}
+
+// FramebufferIsSRGB reports whether fragment colors written to the first draw
+// buffer of the bound draw framebuffer are converted from linear to sRGB. This
+// is only the case if gl.FRAMEBUFFER_SRGB is enabled and the attached image
+// has sRGB encoding; if either is missing, shaders must apply gamma
+// themselves or the output looks too dark.
+func FramebufferIsSRGB() bool {
+	if !gl.IsEnabled(gl.FRAMEBUFFER_SRGB) {
+		return false
+	}
+	attachment := uint32(getInteger(gl.DRAW_BUFFER0))
+	switch attachment {
+	case gl.NONE:
+		return false
+	case gl.BACK:
+		attachment = gl.BACK_LEFT
+	case gl.FRONT:
+		attachment = gl.FRONT_LEFT
+	}
+	info := FramebufferAttachmentInfo(gl.DRAW_FRAMEBUFFER, attachment)
+	return info.ObjectType != gl.NONE && info.ColorEncoding == gl.SRGB
+}
+
+// SetFramebufferSRGB enables or disables the linear to sRGB conversion of
+// colors written to sRGB-encoded framebuffer attachments.
+func SetFramebufferSRGB(enabled bool) {
+	setEnabled(gl.FRAMEBUFFER_SRGB, enabled)
+}
+
+// NewDepthFramebuffer creates a framebuffer with only a depth texture
+// attached, as used for shadow maps. The texture has 24-bit fixed-point
+// depth, or 32-bit floating-point depth if useFloat is set, and is set up for
+// sampling with a sampler2DShadow: depth comparison with gl.LEQUAL, linear
+// filtering for hardware percentage-closer filtering, and clamping to the
+// edge. Draw and read buffers are set to gl.NONE, without which the
+// framebuffer is incomplete on some implementations.
+//
+// If the framebuffer is incomplete, both objects are deleted and an error is
+// returned. The previous framebuffer and texture bindings are restored on
+// return.
+func NewDepthFramebuffer(width, height int32, useFloat bool) (fbo, depthTex uint32, err error) {
+	internalFormat, xtype := uint32(gl.DEPTH_COMPONENT24), uint32(gl.UNSIGNED_INT)
+	if useFloat {
+		internalFormat, xtype = gl.DEPTH_COMPONENT32F, gl.FLOAT
+	}
+
+	prevTex := getInteger(gl.TEXTURE_BINDING_2D)
+	prevDraw := getInteger(gl.DRAW_FRAMEBUFFER_BINDING)
+	prevRead := getInteger(gl.READ_FRAMEBUFFER_BINDING)
+	defer func() {
+		gl.BindTexture(gl.TEXTURE_2D, uint32(prevTex))
+		gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(prevDraw))
+		gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(prevRead))
+	}()
+
+	gl.GenTextures(1, &depthTex)
+	gl.BindTexture(gl.TEXTURE_2D, depthTex)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, int32(internalFormat), width, height, 0, gl.DEPTH_COMPONENT, xtype, nil)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	EnableDepthCompare(gl.TEXTURE_2D, gl.LEQUAL)
+
+	gl.GenFramebuffers(1, &fbo)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.DEPTH_ATTACHMENT, gl.TEXTURE_2D, depthTex, 0)
+	gl.DrawBuffer(gl.NONE)
+	gl.ReadBuffer(gl.NONE)
+	if status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); status != gl.FRAMEBUFFER_COMPLETE {
+		gl.DeleteFramebuffers(1, &fbo)
+		gl.DeleteTextures(1, &depthTex)
+		return 0, 0, fmt.Errorf("depth framebuffer is incomplete: status 0x%X", status)
+	}
+	return fbo, depthTex, nil
+}
@@ -0,0 +1,231 @@
+package glutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// newTestFramebuffer creates and binds a framebuffer with the given number of
+// 4x4 RGBA8 color renderbuffers, which the returned function deletes, binding
+// the default framebuffer again.
+func newTestFramebuffer(t *testing.T, colors int) (fbo uint32, deleteFn func()) {
+	t.Helper()
+	renderbuffers := make([]uint32, colors)
+	gl.GenRenderbuffers(int32(colors), &renderbuffers[0])
+	gl.GenFramebuffers(1, &fbo)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	for i, rb := range renderbuffers {
+		gl.BindRenderbuffer(gl.RENDERBUFFER, rb)
+		gl.RenderbufferStorage(gl.RENDERBUFFER, gl.RGBA8, 4, 4)
+		gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0+uint32(i), gl.RENDERBUFFER, rb)
+	}
+	gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+	if status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); status != gl.FRAMEBUFFER_COMPLETE {
+		t.Fatalf("test framebuffer is incomplete: status 0x%X", status)
+	}
+	return fbo, func() {
+		gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+		gl.DeleteFramebuffers(1, &fbo)
+		gl.DeleteRenderbuffers(int32(colors), &renderbuffers[0])
+	}
+}
+
+func TestDrawBuffers(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFBO := newTestFramebuffer(t, 2)
+	defer deleteFBO()
+
+	if err := DrawBuffers(gl.COLOR_ATTACHMENT1, gl.COLOR_ATTACHMENT0); err != nil {
+		t.Fatal(err)
+	}
+	if b0, b1 := getInteger(gl.DRAW_BUFFER0), getInteger(gl.DRAW_BUFFER1); b0 != gl.COLOR_ATTACHMENT1 || b1 != gl.COLOR_ATTACHMENT0 {
+		t.Errorf("draw buffers = 0x%X, 0x%X; want COLOR_ATTACHMENT1, COLOR_ATTACHMENT0", b0, b1)
+	}
+
+	tooMany := make([]uint32, MaxDrawBuffers()+1)
+	for i := range tooMany {
+		tooMany[i] = gl.NONE
+	}
+	if err := DrawBuffers(tooMany...); err == nil {
+		t.Error("DrawBuffers accepted more than MaxDrawBuffers attachments")
+	}
+	if b0 := getInteger(gl.DRAW_BUFFER0); b0 != gl.COLOR_ATTACHMENT1 {
+		t.Errorf("draw buffer 0 = 0x%X after a rejected DrawBuffers call, want COLOR_ATTACHMENT1", b0)
+	}
+
+	if err := DrawBuffers(); err != nil {
+		t.Fatal(err)
+	}
+	if b0 := getInteger(gl.DRAW_BUFFER0); b0 != gl.NONE {
+		t.Errorf("draw buffer 0 = 0x%X after DrawBuffers(), want NONE", b0)
+	}
+	checkErrors(t)
+}
+
+func TestDrawBufferNone(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFBO := newTestFramebuffer(t, 1)
+	defer deleteFBO()
+
+	DrawBufferNone()
+	if b0 := getInteger(gl.DRAW_BUFFER0); b0 != gl.NONE {
+		t.Errorf("draw buffer 0 = 0x%X after DrawBufferNone, want NONE", b0)
+	}
+	checkErrors(t)
+}
+
+func TestFramebufferAttachmentInfo(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFBO := newTestFramebuffer(t, 1)
+	defer deleteFBO()
+
+	info := FramebufferAttachmentInfo(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0)
+	if info.ObjectType != gl.RENDERBUFFER || !gl.IsRenderbuffer(info.ObjectName) {
+		t.Errorf("color attachment 0 is object 0x%X named %d, want a renderbuffer", info.ObjectType, info.ObjectName)
+	}
+	if info.ComponentType != gl.UNSIGNED_NORMALIZED || info.ColorEncoding != gl.LINEAR {
+		t.Errorf("color attachment 0 has component type 0x%X and encoding 0x%X, want UNSIGNED_NORMALIZED and LINEAR", info.ComponentType, info.ColorEncoding)
+	}
+	if info.RedBits != 8 || info.GreenBits != 8 || info.BlueBits != 8 || info.AlphaBits != 8 || info.DepthBits != 0 {
+		t.Errorf("color attachment 0 has RGBA, depth bits %d, %d, %d, %d, %d; want 8, 8, 8, 8, 0", info.RedBits, info.GreenBits, info.BlueBits, info.AlphaBits, info.DepthBits)
+	}
+
+	if unused := FramebufferAttachmentInfo(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT1); unused != (AttachmentInfo{}) {
+		t.Errorf("unused color attachment 1 = %+v, want the zero AttachmentInfo", unused)
+	}
+	checkErrors(t)
+}
+
+func TestInvalidateFramebuffer(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFBO := newTestFramebuffer(t, 1)
+	defer deleteFBO()
+	var depth uint32
+	gl.GenRenderbuffers(1, &depth)
+	defer gl.DeleteRenderbuffers(1, &depth)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, depth)
+	gl.RenderbufferStorage(gl.RENDERBUFFER, gl.DEPTH_COMPONENT24, 4, 4)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+	gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.DEPTH_ATTACHMENT, gl.RENDERBUFFER, depth)
+
+	InvalidateFramebuffer(gl.FRAMEBUFFER, gl.DEPTH_ATTACHMENT)
+	InvalidateFramebuffer(gl.FRAMEBUFFER)
+	checkErrors(t)
+
+	gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	InvalidateDefaultDepthStencil()
+	checkErrors(t)
+}
+
+func TestClearRegion(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFn := newTestFramebuffer(t, 1)
+	defer deleteFn()
+	var box [4]int32
+	gl.GetIntegerv(gl.SCISSOR_BOX, &box[0])
+	gl.ClearColor(0, 0, 0, 0)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.ClearColor(1, 0, 0, 1)
+	defer gl.ClearColor(0, 0, 0, 0)
+
+	ClearRegion(0, 0, 2, 4, gl.COLOR_BUFFER_BIT)
+	pixels := readFramebuffer(4, 4)
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			want := []byte{0, 0, 0, 0}
+			if x < 2 {
+				want = []byte{255, 0, 0, 255}
+			}
+			if got := pixels[4*(4*y+x):][:4]; !bytes.Equal(got, want) {
+				t.Errorf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+	if gl.IsEnabled(gl.SCISSOR_TEST) {
+		t.Error("ClearRegion left the scissor test enabled")
+	}
+	var after [4]int32
+	gl.GetIntegerv(gl.SCISSOR_BOX, &after[0])
+	if after != box {
+		t.Errorf("scissor box after ClearRegion = %v, want %v", after, box)
+	}
+	checkErrors(t)
+}
+
+func TestSetFramebufferSRGB(t *testing.T) {
+	defer testContext(t)()
+	defer SetFramebufferSRGB(false)
+	_, deleteFn := newTestFramebuffer(t, 1)
+	defer deleteFn()
+	for _, enabled := range []bool{true, false} {
+		SetFramebufferSRGB(enabled)
+		if got := gl.IsEnabled(gl.FRAMEBUFFER_SRGB); got != enabled {
+			t.Errorf("SetFramebufferSRGB(%v): IsEnabled(FRAMEBUFFER_SRGB) = %v", enabled, got)
+		}
+	}
+
+	SetFramebufferSRGB(true)
+	if FramebufferIsSRGB() {
+		t.Error("FramebufferIsSRGB() = true for an RGBA8 attachment")
+	}
+	var rb uint32
+	gl.GenRenderbuffers(1, &rb)
+	defer gl.DeleteRenderbuffers(1, &rb)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb)
+	gl.RenderbufferStorage(gl.RENDERBUFFER, gl.SRGB8_ALPHA8, 4, 4)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+	gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.RENDERBUFFER, rb)
+	if !FramebufferIsSRGB() {
+		t.Error("FramebufferIsSRGB() = false for an SRGB8_ALPHA8 attachment")
+	}
+	SetFramebufferSRGB(false)
+	if FramebufferIsSRGB() {
+		t.Error("FramebufferIsSRGB() = true with FRAMEBUFFER_SRGB disabled")
+	}
+	checkErrors(t)
+}
+
+func TestNewDepthFramebuffer(t *testing.T) {
+	defer testContext(t)()
+	tex, deleteTex := newTestTexture(t, 1, 1)
+	defer deleteTex()
+	for _, tt := range []struct {
+		useFloat      bool
+		componentType uint32
+	}{
+		{false, gl.UNSIGNED_NORMALIZED},
+		{true, gl.FLOAT},
+	} {
+		fbo, depthTex, err := NewDepthFramebuffer(16, 16, tt.useFloat)
+		if err != nil {
+			t.Fatalf("NewDepthFramebuffer(useFloat %v): %v", tt.useFloat, err)
+		}
+		if bound := getInteger(gl.TEXTURE_BINDING_2D); uint32(bound) != tex {
+			t.Errorf("texture binding after NewDepthFramebuffer = %d, want %d", bound, tex)
+		}
+		if bound := getInteger(gl.DRAW_FRAMEBUFFER_BINDING); bound != 0 {
+			t.Errorf("framebuffer binding after NewDepthFramebuffer = %d, want 0", bound)
+		}
+
+		gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+		if status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); status != gl.FRAMEBUFFER_COMPLETE {
+			t.Errorf("useFloat %v: framebuffer status 0x%X, want FRAMEBUFFER_COMPLETE", tt.useFloat, status)
+		}
+		info := FramebufferAttachmentInfo(gl.FRAMEBUFFER, gl.DEPTH_ATTACHMENT)
+		if info.ObjectName != depthTex || info.ComponentType != tt.componentType {
+			t.Errorf("useFloat %v: depth attachment %+v, want texture %d with component type 0x%X", tt.useFloat, info, depthTex, tt.componentType)
+		}
+		gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+
+		gl.BindTexture(gl.TEXTURE_2D, depthTex)
+		if mode := texParameter(gl.TEXTURE_2D, gl.TEXTURE_COMPARE_MODE); mode != gl.COMPARE_REF_TO_TEXTURE {
+			t.Errorf("useFloat %v: TEXTURE_COMPARE_MODE = 0x%X, want COMPARE_REF_TO_TEXTURE", tt.useFloat, mode)
+		}
+		gl.BindTexture(gl.TEXTURE_2D, tex)
+		gl.DeleteFramebuffers(1, &fbo)
+		gl.DeleteTextures(1, &depthTex)
+	}
+	checkErrors(t)
+}
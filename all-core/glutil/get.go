@@ -0,0 +1,38 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// getInteger returns the value of single-valued integer state.
+func getInteger(pname uint32) int32 {
+	var v int32
+	gl.GetIntegerv(pname, &v)
+	return v
+}
+
+// getString returns the value of string state such as gl.VENDOR, or an empty
+// string if it is not available.
+func getString(name uint32) string {
+	s := gl.GetString(name)
+	if s == nil {
+		return ""
+	}
+	return gl.GoStr(s)
+}
+
+// GetIntegerIndexed returns the value of indexed integer state, such as
+// gl.UNIFORM_BUFFER_BINDING for a particular uniform buffer binding point.
+func GetIntegerIndexed(name uint32, index uint32) int32 {
+	var v int32
+	gl.GetIntegeri_v(name, index, &v)
+	return v
+}
+
+// GetInteger64Indexed returns the value of indexed 64-bit integer state. It
+// should be used for buffer range offsets and sizes such as
+// gl.UNIFORM_BUFFER_START and gl.UNIFORM_BUFFER_SIZE, which may not fit in 32
+// bits.
+func GetInteger64Indexed(name uint32, index uint32) int64 {
+	var v int64
+	gl.GetInteger64i_v(name, index, &v)
+	return v
+}
@@ -0,0 +1,34 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestGetIntegerIndexed(t *testing.T) {
+	defer testContext(t)()
+	offset := int(getInteger(gl.UNIFORM_BUFFER_OFFSET_ALIGNMENT))
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	defer gl.DeleteBuffers(1, &buf)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, buf)
+	gl.BufferData(gl.UNIFORM_BUFFER, offset+64, nil, gl.STATIC_DRAW)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, 0)
+
+	gl.BindBufferRange(gl.UNIFORM_BUFFER, 1, buf, offset, 64)
+	defer gl.BindBufferBase(gl.UNIFORM_BUFFER, 1, 0)
+	if b := GetIntegerIndexed(gl.UNIFORM_BUFFER_BINDING, 1); uint32(b) != buf {
+		t.Errorf("uniform buffer binding 1 = %d, want %d", b, buf)
+	}
+	if b := GetIntegerIndexed(gl.UNIFORM_BUFFER_BINDING, 0); b != 0 {
+		t.Errorf("uniform buffer binding 0 = %d, want 0", b)
+	}
+	if start := GetInteger64Indexed(gl.UNIFORM_BUFFER_START, 1); start != int64(offset) {
+		t.Errorf("uniform buffer binding 1 starts at %d, want %d", start, offset)
+	}
+	if size := GetInteger64Indexed(gl.UNIFORM_BUFFER_SIZE, 1); size != 64 {
+		t.Errorf("uniform buffer binding 1 has size %d, want 64", size)
+	}
+	checkErrors(t)
+}
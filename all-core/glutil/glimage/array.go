@@ -0,0 +1,58 @@
+package glimage
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+)
+
+// NewTexture2DArray creates a 2D array texture with one layer per image, in
+// order. All images must have the same size, and the number of layers must
+// not exceed glutil.MaxArrayLayers. Images are uploaded with their top row
+// first, as for gl.TexImage2D with an *image.RGBA. The texture uses linear
+// filtering without mipmaps and clamps to the edge. The previously bound 2D
+// array texture is restored on return.
+func NewTexture2DArray(layers []image.Image) (uint32, error) {
+	if len(layers) == 0 {
+		return 0, errors.New("texture array has no layers")
+	}
+	if max := glutil.MaxArrayLayers(); len(layers) > int(max) {
+		return 0, fmt.Errorf("texture array has %d layers, at most %d are supported", len(layers), max)
+	}
+	var size image.Point
+	for i, layer := range layers {
+		if layer == nil {
+			return 0, fmt.Errorf("texture array layer %d is nil", i)
+		}
+		if i == 0 {
+			size = layer.Bounds().Size()
+		} else if s := layer.Bounds().Size(); s != size {
+			return 0, fmt.Errorf("texture array layer %d is %dx%d, expected %dx%d", i, s.X, s.Y, size.X, size.Y)
+		}
+	}
+	if size.X == 0 || size.Y == 0 {
+		return 0, errors.New("texture array layers are empty")
+	}
+
+	var prev int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D_ARRAY, &prev)
+	defer gl.BindTexture(gl.TEXTURE_2D_ARRAY, uint32(prev))
+
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D_ARRAY, tex)
+	defer defaultUnpack()()
+	gl.TexImage3D(gl.TEXTURE_2D_ARRAY, 0, gl.RGBA8, int32(size.X), int32(size.Y), int32(len(layers)), 0, gl.RGBA, gl.UNSIGNED_BYTE, nil)
+	for i, layer := range layers {
+		rgba := toRGBA(layer)
+		gl.TexSubImage3D(gl.TEXTURE_2D_ARRAY, 0, 0, 0, int32(i), int32(size.X), int32(size.Y), 1, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(rgba.Pix))
+	}
+	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	return tex, nil
+}
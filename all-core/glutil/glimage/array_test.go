@@ -0,0 +1,51 @@
+package glimage
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestNewTexture2DArray(t *testing.T) {
+	defer testContext(t)()
+	layers := []image.Image{
+		solidImage(2, color.RGBA{255, 0, 0, 255}),
+		solidImage(2, color.RGBA{0, 255, 0, 255}),
+		solidImage(2, color.RGBA{0, 0, 255, 128}),
+	}
+	tex, err := NewTexture2DArray(layers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteTextures(1, &tex)
+
+	gl.BindTexture(gl.TEXTURE_2D_ARRAY, tex)
+	defer gl.BindTexture(gl.TEXTURE_2D_ARRAY, 0)
+	var depth int32
+	gl.GetTexLevelParameteriv(gl.TEXTURE_2D_ARRAY, 0, gl.TEXTURE_DEPTH, &depth)
+	if depth != 3 {
+		t.Errorf("texture array has %d layers, want 3", depth)
+	}
+	const layerSize = 2 * 2 * 4
+	data := make([]byte, 3*layerSize)
+	gl.GetTexImage(gl.TEXTURE_2D_ARRAY, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(data))
+	for i, layer := range layers {
+		if got, want := data[i*layerSize:(i+1)*layerSize], layer.(*image.RGBA).Pix; !bytes.Equal(got, want) {
+			t.Errorf("layer %d = %v, want %v", i, got, want)
+		}
+	}
+
+	for _, bad := range [][]image.Image{
+		nil,
+		{nil},
+		{layers[0], solidImage(4, color.RGBA{})},
+	} {
+		if _, err := NewTexture2DArray(bad); err == nil {
+			t.Errorf("NewTexture2DArray accepted %v", bad)
+		}
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,40 @@
+package glimage
+
+import (
+	"image/color"
+	"math"
+)
+
+// ColorToFloat4 converts c to normalized red, green, blue, and alpha
+// components in the range [0, 1], suitable for gl.ClearColor or a vec4
+// uniform. Unlike color.Color.RGBA, the color components are not
+// premultiplied by alpha.
+func ColorToFloat4(c color.Color) [4]float32 {
+	n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	return [4]float32{
+		float32(n.R) / 0xffff,
+		float32(n.G) / 0xffff,
+		float32(n.B) / 0xffff,
+		float32(n.A) / 0xffff,
+	}
+}
+
+// ColorToLinearFloat4 is like ColorToFloat4 but additionally treats the color
+// components of c as sRGB encoded and converts them to linear values, as
+// needed when rendering into a linear or gl.FRAMEBUFFER_SRGB framebuffer.
+// Alpha is never gamma encoded and is left as is.
+func ColorToLinearFloat4(c color.Color) [4]float32 {
+	f := ColorToFloat4(c)
+	for i := 0; i < 3; i++ {
+		f[i] = srgbToLinear(f[i])
+	}
+	return f
+}
+
+// srgbToLinear decodes a single sRGB encoded component.
+func srgbToLinear(v float32) float32 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return float32(math.Pow((float64(v)+0.055)/1.055, 2.4))
+}
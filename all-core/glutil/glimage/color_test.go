@@ -0,0 +1,54 @@
+package glimage
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func float4Near(a, b [4]float32) bool {
+	for i := range a {
+		if math.Abs(float64(a[i]-b[i])) > 1e-3 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestColorToFloat4(t *testing.T) {
+	tests := []struct {
+		c    color.Color
+		want [4]float32
+	}{
+		{color.RGBA{255, 0, 0, 255}, [4]float32{1, 0, 0, 1}},
+		{color.RGBA{0, 0, 0, 0}, [4]float32{0, 0, 0, 0}},
+		// Premultiplied: half-transparent orange.
+		{color.RGBA{128, 64, 0, 128}, [4]float32{1, 0.5, 0, 128.0 / 255}},
+		{color.NRGBA{255, 128, 0, 128}, [4]float32{1, 128.0 / 255, 0, 128.0 / 255}},
+		{color.NRGBA{10, 20, 30, 0}, [4]float32{0, 0, 0, 0}},
+		{color.Gray{51}, [4]float32{0.2, 0.2, 0.2, 1}},
+		{color.Gray16{0xffff}, [4]float32{1, 1, 1, 1}},
+	}
+	for _, tt := range tests {
+		if got := ColorToFloat4(tt.c); !float4Near(got, tt.want) {
+			t.Errorf("ColorToFloat4(%#v) = %v, want %v", tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestColorToLinearFloat4(t *testing.T) {
+	tests := []struct {
+		c    color.Color
+		want [4]float32
+	}{
+		{color.Gray{0}, [4]float32{0, 0, 0, 1}},
+		{color.Gray{255}, [4]float32{1, 1, 1, 1}},
+		{color.Gray{188}, [4]float32{0.5029, 0.5029, 0.5029, 1}},
+		{color.NRGBA{8, 255, 0, 128}, [4]float32{0.0024, 1, 0, 128.0 / 255}},
+	}
+	for _, tt := range tests {
+		if got := ColorToLinearFloat4(tt.c); !float4Near(got, tt.want) {
+			t.Errorf("ColorToLinearFloat4(%#v) = %v, want %v", tt.c, got, tt.want)
+		}
+	}
+}
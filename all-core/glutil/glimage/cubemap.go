@@ -0,0 +1,60 @@
+package glimage
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+)
+
+// TexCubemapFromImages creates a cube map texture from six square images of
+// equal size. The faces are expected in the order of the cube map targets:
+//
+//	+X, -X, +Y, -Y, +Z, -Z
+//
+// Each image is uploaded with its top row first, matching the usual cube map
+// face orientation, so images do not need to be flipped. The texture uses
+// linear filtering, clamps to the edge, and seamless cube map filtering is
+// enabled. The previously bound cube map texture is restored on return.
+func TexCubemapFromImages(faces [6]image.Image) (uint32, error) {
+	var size int
+	for i, face := range faces {
+		if face == nil {
+			return 0, fmt.Errorf("cube map face %d is nil", i)
+		}
+		b := face.Bounds()
+		if b.Dx() != b.Dy() {
+			return 0, fmt.Errorf("cube map face %d is not square: %dx%d", i, b.Dx(), b.Dy())
+		}
+		if i == 0 {
+			size = b.Dx()
+		} else if b.Dx() != size {
+			return 0, fmt.Errorf("cube map face %d is %dx%d, expected %dx%d", i, b.Dx(), b.Dy(), size, size)
+		}
+	}
+	if size == 0 {
+		return 0, errors.New("cube map faces are empty")
+	}
+
+	var prev int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_CUBE_MAP, &prev)
+	defer gl.BindTexture(gl.TEXTURE_CUBE_MAP, uint32(prev))
+
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_CUBE_MAP, tex)
+	defer defaultUnpack()()
+	for i, face := range faces {
+		rgba := toRGBA(face)
+		gl.TexImage2D(gl.TEXTURE_CUBE_MAP_POSITIVE_X+uint32(i), 0, gl.RGBA8, int32(size), int32(size), 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(rgba.Pix))
+	}
+	gl.TexParameteri(gl.TEXTURE_CUBE_MAP, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_CUBE_MAP, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_CUBE_MAP, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_CUBE_MAP, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_CUBE_MAP, gl.TEXTURE_WRAP_R, gl.CLAMP_TO_EDGE)
+	glutil.SetSeamlessCubemaps(true)
+	return tex, nil
+}
@@ -0,0 +1,49 @@
+package glimage
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestTexCubemapFromImages(t *testing.T) {
+	defer testContext(t)()
+	seamless := gl.IsEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS)
+	defer func() {
+		if !seamless {
+			gl.Disable(gl.TEXTURE_CUBE_MAP_SEAMLESS)
+		}
+	}()
+
+	var faces [6]image.Image
+	for i := range faces {
+		faces[i] = solidImage(2, color.RGBA{uint8(40 * i), uint8(255 - 40*i), uint8(i), 255})
+	}
+	tex, err := TexCubemapFromImages(faces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteTextures(1, &tex)
+	if !gl.IsEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS) {
+		t.Error("seamless cube map filtering is not enabled")
+	}
+
+	gl.BindTexture(gl.TEXTURE_CUBE_MAP, tex)
+	defer gl.BindTexture(gl.TEXTURE_CUBE_MAP, 0)
+	for i, face := range faces {
+		got := make([]byte, 2*2*4)
+		gl.GetTexImage(gl.TEXTURE_CUBE_MAP_POSITIVE_X+uint32(i), 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(got))
+		if want := face.(*image.RGBA).Pix; !bytes.Equal(got, want) {
+			t.Errorf("face %d = %v, want %v", i, got, want)
+		}
+	}
+
+	faces[3] = solidImage(4, color.RGBA{})
+	if _, err := TexCubemapFromImages(faces); err == nil {
+		t.Error("TexCubemapFromImages accepted faces of different sizes")
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,6 @@
+// Package glimage provides helpers for moving Go images to and from OpenGL
+// textures and framebuffers.
+//
+// Like glutil, it is built on the all-core bindings and requires gl.Init to
+// have been called under an active OpenGL context.
+package glimage
@@ -0,0 +1,41 @@
+package glimage
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/glutil"
+	"github.com/go-gl/gl/internal/gltest"
+)
+
+// testContext makes the shared headless test context current for the calling
+// test, skipping the test if no OpenGL context can be created. The returned
+// function must be deferred:
+//
+//	defer testContext(t)()
+func testContext(t *testing.T) (release func()) {
+	t.Helper()
+	release, err := gltest.Acquire()
+	if err != nil {
+		t.Skipf("no OpenGL context: %v", err)
+	}
+	return release
+}
+
+// checkErrors fails the test if OpenGL recorded any errors.
+func checkErrors(t *testing.T) {
+	t.Helper()
+	for _, code := range glutil.GetErrors() {
+		t.Errorf("GL error %s", glutil.ErrorName(code))
+	}
+}
+
+// solidImage returns a size by size image filled with c.
+func solidImage(size int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
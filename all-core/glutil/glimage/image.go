@@ -0,0 +1,35 @@
+package glimage
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+)
+
+// toRGBA returns img as a tightly packed *image.RGBA whose bounds start at the
+// origin, converting or copying it if necessary.
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) && rgba.Stride == 4*rgba.Rect.Dx() {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, b.Min, draw.Src)
+	return rgba
+}
+
+// defaultUnpack resets the pixel unpack parameters so that tightly packed
+// *image.RGBA pixels upload correctly, and returns a function restoring the
+// previous parameters.
+func defaultUnpack() (restore func()) {
+	saved := glutil.SavePixelStore()
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 4)
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 0)
+	gl.PixelStorei(gl.UNPACK_IMAGE_HEIGHT, 0)
+	gl.PixelStorei(gl.UNPACK_SKIP_PIXELS, 0)
+	gl.PixelStorei(gl.UNPACK_SKIP_ROWS, 0)
+	gl.PixelStorei(gl.UNPACK_SKIP_IMAGES, 0)
+	return saved.Restore
+}
@@ -0,0 +1,26 @@
+package glimage
+
+import (
+	"image/color"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// ReadPixel returns the color of the pixel of the read framebuffer at x and y,
+// counted from the lower left corner, as used for color picking and checking
+// rendering results.
+func ReadPixel(x, y int32) color.RGBA {
+	var pixel [4]uint8
+	readPixels(x, y, 1, 1, gl.RGBA, gl.UNSIGNED_BYTE, pixel[:])
+	return color.RGBA{R: pixel[0], G: pixel[1], B: pixel[2], A: pixel[3]}
+}
+
+// ReadPixelUint returns the value of the pixel of the read framebuffer at x
+// and y, counted from the lower left corner. The read buffer must have an
+// unsigned integer format such as gl.R32UI, as used for picking by rendering
+// object IDs; reading it with a non-integer format like gl.RED is an error.
+func ReadPixelUint(x, y int32) uint32 {
+	var id uint32
+	readPixels(x, y, 1, 1, gl.RED_INTEGER, gl.UNSIGNED_INT, &id)
+	return id
+}
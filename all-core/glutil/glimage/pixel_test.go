@@ -0,0 +1,62 @@
+package glimage
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/internal/gltest"
+)
+
+func TestReadPixel(t *testing.T) {
+	defer testContext(t)()
+	gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.ClearColor(0.2, 0.4, 0.6, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.ClearColor(0, 0, 0, 0)
+
+	if got, want := ReadPixel(3, 5), (color.RGBA{51, 102, 153, 255}); got != want {
+		t.Errorf("ReadPixel(3, 5) = %v, want %v", got, want)
+	}
+	drawHalves()
+	if got := ReadPixel(0, 0); got != green {
+		t.Errorf("ReadPixel(0, 0) = %v, want %v", got, green)
+	}
+	checkErrors(t)
+}
+
+func TestReadPixelUint(t *testing.T) {
+	defer testContext(t)()
+	var fbo, rb uint32
+	gl.GenRenderbuffers(1, &rb)
+	defer gl.DeleteRenderbuffers(1, &rb)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb)
+	gl.RenderbufferStorage(gl.RENDERBUFFER, gl.R32UI, 4, 4)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+	gl.GenFramebuffers(1, &fbo)
+	defer gl.DeleteFramebuffers(1, &fbo)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	defer gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.RENDERBUFFER, rb)
+	if status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); status != gl.FRAMEBUFFER_COMPLETE {
+		t.Fatalf("R32UI framebuffer is incomplete: status 0x%X", status)
+	}
+
+	// Write the ID only to the pixel at (2, 1), as a picking pass drawing a
+	// single object there would.
+	background, id := [4]uint32{}, [4]uint32{0xDEADBEEF}
+	gl.ClearBufferuiv(gl.COLOR, 0, &background[0])
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.Scissor(2, 1, 1, 1)
+	gl.ClearBufferuiv(gl.COLOR, 0, &id[0])
+	gl.Disable(gl.SCISSOR_TEST)
+	gl.Scissor(0, 0, gltest.Width, gltest.Height)
+
+	if got := ReadPixelUint(2, 1); got != id[0] {
+		t.Errorf("ReadPixelUint(2, 1) = 0x%X, want 0x%X", got, id[0])
+	}
+	if got := ReadPixelUint(1, 2); got != 0 {
+		t.Errorf("ReadPixelUint(1, 2) = 0x%X, want 0", got)
+	}
+	checkErrors(t)
+}
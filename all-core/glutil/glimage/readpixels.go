@@ -0,0 +1,82 @@
+package glimage
+
+import (
+	"image"
+	"image/png"
+	"os"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+)
+
+// readPixels reads a rectangle of the read framebuffer into dst, a slice or
+// pointer as accepted by gl.Ptr that must be large enough, using default pixel
+// pack parameters and no pack buffer.
+func readPixels(x, y, width, height int32, format, xtype uint32, dst interface{}) {
+	saved := glutil.SavePixelStore()
+	defer saved.Restore()
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	gl.PixelStorei(gl.PACK_ROW_LENGTH, 0)
+	gl.PixelStorei(gl.PACK_SKIP_PIXELS, 0)
+	gl.PixelStorei(gl.PACK_SKIP_ROWS, 0)
+
+	var packBuffer int32
+	gl.GetIntegerv(gl.PIXEL_PACK_BUFFER_BINDING, &packBuffer)
+	if packBuffer != 0 {
+		gl.BindBuffer(gl.PIXEL_PACK_BUFFER, 0)
+		defer gl.BindBuffer(gl.PIXEL_PACK_BUFFER, uint32(packBuffer))
+	}
+	gl.ReadPixels(x, y, width, height, format, xtype, gl.Ptr(dst))
+}
+
+// ReadPixelsImage reads a rectangle of the read framebuffer, with x and y
+// giving its lower left corner, into an image. OpenGL returns rows bottom to
+// top, so they are flipped to make the image upright.
+//
+// If the implementation prefers reading gl.BGRA pixels, as reported by
+// glutil.PreferredReadFormat, they are read in that order and swizzled,
+// which is usually faster than having the driver convert them.
+func ReadPixelsImage(x, y, width, height int32) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	if width <= 0 || height <= 0 {
+		return img
+	}
+	if format, xtype := glutil.PreferredReadFormat(); format == gl.BGRA && xtype == gl.UNSIGNED_BYTE {
+		readPixels(x, y, width, height, gl.BGRA, gl.UNSIGNED_BYTE, img.Pix)
+		for i := 0; i < len(img.Pix); i += 4 {
+			img.Pix[i], img.Pix[i+2] = img.Pix[i+2], img.Pix[i]
+		}
+	} else {
+		readPixels(x, y, width, height, gl.RGBA, gl.UNSIGNED_BYTE, img.Pix)
+	}
+	flipRows(img)
+	return img
+}
+
+// flipRows mirrors img vertically in place.
+func flipRows(img *image.RGBA) {
+	h := img.Rect.Dy()
+	row := make([]uint8, img.Stride)
+	for top, bottom := 0, h-1; top < bottom; top, bottom = top+1, bottom-1 {
+		t := img.Pix[top*img.Stride : (top+1)*img.Stride]
+		b := img.Pix[bottom*img.Stride : (bottom+1)*img.Stride]
+		copy(row, t)
+		copy(t, b)
+		copy(b, row)
+	}
+}
+
+// SaveScreenshotPNG reads a rectangle of the read framebuffer like
+// ReadPixelsImage and writes it to a PNG file at path.
+func SaveScreenshotPNG(path string, x, y, width, height int32) error {
+	img := ReadPixelsImage(x, y, width, height)
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
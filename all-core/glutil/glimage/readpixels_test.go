@@ -0,0 +1,66 @@
+package glimage
+
+import (
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+	"github.com/go-gl/gl/internal/gltest"
+)
+
+// drawHalves clears the default framebuffer to red in its top half and green
+// in its bottom half.
+func drawHalves() {
+	gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.ClearColor(1, 0, 0, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.ClearColor(0, 1, 0, 1)
+	glutil.ClearRegion(0, 0, gltest.Width, gltest.Height/2, gl.COLOR_BUFFER_BIT)
+	gl.ClearColor(0, 0, 0, 0)
+}
+
+var (
+	red   = color.RGBA{255, 0, 0, 255}
+	green = color.RGBA{0, 255, 0, 255}
+)
+
+func TestSaveScreenshotPNG(t *testing.T) {
+	defer testContext(t)()
+	drawHalves()
+
+	dir, err := ioutil.TempDir("", "glimage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "screenshot.png")
+	if err := SaveScreenshotPNG(path, 0, 0, gltest.Width, gltest.Height); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b := img.Bounds(); b.Dx() != gltest.Width || b.Dy() != gltest.Height {
+		t.Fatalf("screenshot is %dx%d, want %dx%d", b.Dx(), b.Dy(), gltest.Width, gltest.Height)
+	}
+	// Image rows run top to bottom, so the top half comes first.
+	if c := color.RGBAModel.Convert(img.At(0, 0)); c != red {
+		t.Errorf("top left pixel = %v, want %v", c, red)
+	}
+	if c := color.RGBAModel.Convert(img.At(0, gltest.Height-1)); c != green {
+		t.Errorf("bottom left pixel = %v, want %v", c, green)
+	}
+	checkErrors(t)
+}
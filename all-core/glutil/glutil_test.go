@@ -0,0 +1,65 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/internal/gltest"
+)
+
+// testContext makes the shared headless test context current for the calling
+// test, skipping the test if no OpenGL context can be created, for example
+// because there is no display. The returned function must be deferred:
+//
+//	defer testContext(t)()
+func testContext(t *testing.T) (release func()) {
+	t.Helper()
+	release, err := gltest.Acquire()
+	if err != nil {
+		t.Skipf("no OpenGL context: %v", err)
+	}
+	return release
+}
+
+// requireContextVersion skips the test if the current context is older than
+// the given OpenGL version.
+func requireContextVersion(t *testing.T, major, minor int32) {
+	t.Helper()
+	if !versionAtLeast(major, minor) {
+		t.Skipf("requires OpenGL %d.%d", major, minor)
+	}
+}
+
+// checkErrors fails the test if OpenGL recorded any errors.
+func checkErrors(t *testing.T) {
+	t.Helper()
+	for _, code := range GetErrors() {
+		t.Errorf("GL error %s", ErrorName(code))
+	}
+}
+
+// fullscreenVertexShader draws a triangle covering the viewport from three
+// vertices without any attributes.
+const fullscreenVertexShader = `#version 330 core
+void main() {
+	vec2 pos = vec2(gl_VertexID & 1, gl_VertexID >> 1) * 4.0 - 1.0;
+	gl_Position = vec4(pos, 0.0, 1.0);
+}
+`
+
+const emptyFragmentShader = `#version 330 core
+out vec4 fragColor;
+void main() {
+	fragColor = vec4(1.0);
+}
+`
+
+// newTestProgram links fullscreenVertexShader and emptyFragmentShader into a
+// program, which the caller deletes.
+func newTestProgram(t *testing.T) uint32 {
+	t.Helper()
+	program, err := NewProgram(fullscreenVertexShader, emptyFragmentShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return program
+}
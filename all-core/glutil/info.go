@@ -0,0 +1,67 @@
+package glutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// Vendor memory information enums. These come from extensions that are not
+// part of the core profile, so the all-core bindings do not define them.
+const (
+	gpuMemoryInfoTotalAvailableMemoryNVX   = 0x9048
+	gpuMemoryInfoCurrentAvailableVidmemNVX = 0x9049
+	textureFreeMemoryATI                   = 0x87FC
+)
+
+// GPUMemoryInfo returns the total and currently available video memory in
+// kilobytes, as reported by GL_NVX_gpu_memory_info or GL_ATI_meminfo.
+//
+// GL_ATI_meminfo only reports free memory, so totalKB is zero when that
+// extension is used. If neither extension is supported ok is false.
+func GPUMemoryInfo() (totalKB, availableKB int, ok bool) {
+	switch {
+	case extensionSupported("GL_NVX_gpu_memory_info"):
+		var total, available int32
+		gl.GetIntegerv(gpuMemoryInfoTotalAvailableMemoryNVX, &total)
+		gl.GetIntegerv(gpuMemoryInfoCurrentAvailableVidmemNVX, &available)
+		return int(total), int(available), true
+	case extensionSupported("GL_ATI_meminfo"):
+		// The query fills in four values, the first being the total free
+		// memory in the texture pool.
+		var free [4]int32
+		gl.GetIntegerv(textureFreeMemoryATI, &free[0])
+		return 0, int(free[0]), true
+	}
+	return 0, 0, false
+}
+
+// ContextInfo returns a multi-line, human-readable summary of the current
+// context, including the vendor, renderer, versions, profile, and a selection
+// of implementation limits. It is intended to be included in bug reports.
+func ContextInfo() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Vendor:       %s\n", getString(gl.VENDOR))
+	fmt.Fprintf(&b, "Renderer:     %s\n", getString(gl.RENDERER))
+	fmt.Fprintf(&b, "Version:      %s\n", getString(gl.VERSION))
+	fmt.Fprintf(&b, "GLSL version: %s\n", getString(gl.SHADING_LANGUAGE_VERSION))
+	fmt.Fprintf(&b, "Profile:      %s\n", strings.Join(BitfieldNames(uint32(getInteger(gl.CONTEXT_PROFILE_MASK)), ContextProfileBits), " | "))
+	fmt.Fprintf(&b, "Flags:        %s\n", strings.Join(BitfieldNames(uint32(getInteger(gl.CONTEXT_FLAGS)), ContextFlagBits), " | "))
+	limits := []struct {
+		name  string
+		pname uint32
+	}{
+		{"MAX_TEXTURE_SIZE", gl.MAX_TEXTURE_SIZE},
+		{"MAX_COMBINED_TEXTURE_IMAGE_UNITS", gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS},
+		{"MAX_VERTEX_ATTRIBS", gl.MAX_VERTEX_ATTRIBS},
+		{"MAX_UNIFORM_BUFFER_BINDINGS", gl.MAX_UNIFORM_BUFFER_BINDINGS},
+		{"MAX_DRAW_BUFFERS", gl.MAX_DRAW_BUFFERS},
+		{"MAX_COLOR_ATTACHMENTS", gl.MAX_COLOR_ATTACHMENTS},
+		{"MAX_SAMPLES", gl.MAX_SAMPLES},
+	}
+	for _, l := range limits {
+		fmt.Fprintf(&b, "%s: %d\n", l.name, getInteger(l.pname))
+	}
+	return b.String()
+}
@@ -0,0 +1,37 @@
+package glutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestGPUMemoryInfo(t *testing.T) {
+	defer testContext(t)()
+	total, available, ok := GPUMemoryInfo()
+	checkErrors(t)
+	if !ok {
+		if total != 0 || available != 0 {
+			t.Errorf("GPUMemoryInfo() = %d, %d, false; want zero sizes", total, available)
+		}
+		return
+	}
+	if available <= 0 || (total != 0 && available > total) {
+		t.Errorf("GPUMemoryInfo() = %d, %d, true; want 0 < available <= total", total, available)
+	}
+}
+
+func TestContextInfo(t *testing.T) {
+	defer testContext(t)()
+	info := ContextInfo()
+	checkErrors(t)
+	for _, name := range []uint32{gl.VENDOR, gl.RENDERER, gl.VERSION} {
+		if s := getString(name); s == "" || !strings.Contains(info, s) {
+			t.Errorf("ContextInfo() does not contain %q:\n%s", s, info)
+		}
+	}
+	if !strings.Contains(info, "CONTEXT_CORE_PROFILE_BIT") {
+		t.Errorf("ContextInfo() does not report the core profile:\n%s", info)
+	}
+}
@@ -0,0 +1,217 @@
+package glutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// layoutRules selects the GLSL rules used to lay out interface block members.
+type layoutRules int
+
+const (
+	std140 layoutRules = iota
+	std430
+)
+
+// layoutVisitor is called by layout for every member of a block. Vectors and
+// scalars that are not part of a vector are reported as leaves, struct fields
+// as fields; a scalar or vector struct field is reported as both.
+type layoutVisitor func(path string, offset, goOffset int, field, leaf bool)
+
+// scalarSize returns the size of a Go type usable as a GLSL scalar, or zero if
+// there is none.
+func scalarSize(t reflect.Type) int {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Int32, reflect.Uint32:
+		return 4
+	case reflect.Float64:
+		return 8
+	}
+	return 0
+}
+
+func roundUp(n, align int) int {
+	return (n + align - 1) / align * align
+}
+
+// layout returns the size and base alignment of t under the rules r, calling
+// visit, if not nil, for each member with its offset in the block and in Go
+// memory. See Std140Layout for the mapping of Go types to GLSL types.
+func (r layoutRules) layout(t reflect.Type, path string, offset, goOffset int, visit layoutVisitor) (size, align int, err error) {
+	if s := scalarSize(t); s != 0 {
+		if visit != nil {
+			visit(path, offset, goOffset, false, true)
+		}
+		return s, s, nil
+	}
+	switch t.Kind() {
+	case reflect.Array:
+		elem := t.Elem()
+		if s := scalarSize(elem); s != 0 && t.Len() >= 2 && t.Len() <= 4 {
+			if visit != nil {
+				visit(path, offset, goOffset, false, true)
+			}
+			if t.Len() == 2 {
+				return 2 * s, 2 * s, nil
+			}
+			return t.Len() * s, 4 * s, nil
+		}
+		elemSize, elemAlign, err := r.layout(elem, path+"[0]", offset, goOffset, nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		if r == std140 {
+			elemAlign = roundUp(elemAlign, 16)
+		}
+		stride := roundUp(elemSize, elemAlign)
+		if visit != nil {
+			for i := 0; i < t.Len(); i++ {
+				r.layout(elem, fmt.Sprintf("%s[%d]", path, i), offset+i*stride, goOffset+i*int(elem.Size()), visit)
+			}
+		}
+		return stride * t.Len(), elemAlign, nil
+	case reflect.Struct:
+		align = 1
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Name == "_" {
+				continue
+			}
+			name := f.Name
+			if path != "" {
+				name = path + "." + f.Name
+			}
+			_, fieldAlign, err := r.layout(f.Type, name, 0, 0, nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			size = roundUp(size, fieldAlign)
+			if visit != nil {
+				visit(name, offset+size, goOffset+int(f.Offset), true, false)
+			}
+			fieldSize, _, _ := r.layout(f.Type, name, offset+size, goOffset+int(f.Offset), visit)
+			size += fieldSize
+			if fieldAlign > align {
+				align = fieldAlign
+			}
+		}
+		if r == std140 {
+			align = roundUp(align, 16)
+		}
+		return roundUp(size, align), align, nil
+	}
+	if path == "" {
+		return 0, 0, fmt.Errorf("unsupported block member type %s", t)
+	}
+	return 0, 0, fmt.Errorf("%s: unsupported block member type %s", path, t)
+}
+
+// checkGoLayout reports an error naming the first member of the struct type t
+// whose Go offset differs from its offset under the rules r, which means the
+// struct's memory cannot be copied into a buffer as is. It returns the size of
+// the block.
+func (r layoutRules) checkGoLayout(t reflect.Type) (int, error) {
+	if t.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("unsupported type %s; must be a struct", t)
+	}
+	var mismatch error
+	size, _, err := r.layout(t, "", 0, 0, func(path string, offset, goOffset int, field, leaf bool) {
+		if leaf && offset != goOffset && mismatch == nil {
+			mismatch = fmt.Errorf("%s is at offset %d, but must be at offset %d; add padding fields", path, goOffset, offset)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, mismatch
+}
+
+// blockLayout implements Std140Layout and Std430Layout.
+func (r layoutRules) blockLayout(sample interface{}) (size int, offsets map[string]int, err error) {
+	t := reflect.TypeOf(sample)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return 0, nil, fmt.Errorf("unsupported type %T; must be a struct", sample)
+	}
+	offsets = make(map[string]int)
+	size, _, err = r.layout(t, "", 0, 0, func(path string, offset, goOffset int, field, leaf bool) {
+		if field {
+			offsets[path] = offset
+		}
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return size, offsets, nil
+}
+
+// Std140Layout computes the layout of an interface block declared with
+// layout(std140), as used for uniform blocks, that the struct type of sample
+// mirrors. Sample may also be a pointer to a struct. It returns the size of the
+// block in bytes and the offset of every struct field, keyed by path, such as
+// "Lights[1].Color" for the Color field of the second element of the Lights
+// array.
+//
+// The Go types map to GLSL types as follows:
+//
+//   - float32, int32, uint32 and float64 are float, int, uint and double.
+//   - Arrays of 2 to 4 scalars are vectors, e.g. [3]float32 is a vec3.
+//   - Arrays of 2 to 4 vectors are matrices in column-major order, e.g.
+//     [4][4]float32 is a mat4 and [3][2]float32 a mat3x2.
+//   - Other arrays are GLSL arrays and structs are GLSL structs. Fields named
+//     _ are Go padding and are not part of the block.
+//
+// Other types, including bool, are reported as errors.
+func Std140Layout(sample interface{}) (size int, offsets map[string]int, err error) {
+	return std140.blockLayout(sample)
+}
+
+// Std430Layout is like Std140Layout for blocks declared with layout(std430),
+// which is only available for shader storage blocks. Unlike std140, the
+// alignment and stride of arrays and structs are not rounded up to 16 bytes,
+// so for example a float array is tightly packed.
+func Std430Layout(sample interface{}) (size int, offsets map[string]int, err error) {
+	return std430.blockLayout(sample)
+}
+
+// UpdateUniformBuffer uploads data, a struct or pointer to a struct mirroring
+// a uniform block declared with layout(std140), to the start of buffer. See
+// Std140Layout for how Go types map to GLSL types.
+//
+// Since the struct's memory is copied as is, every member must already be at
+// its std140 offset, which usually requires explicit padding fields named _.
+// For example, a vec3 followed by a float fits in 16 bytes, but a vec3
+// followed by another vec3 needs 4 bytes of padding in between. If a member is
+// misplaced or of an unsupported type, an error naming it is returned and
+// nothing is uploaded. The previous gl.UNIFORM_BUFFER binding is restored on
+// return.
+func UpdateUniformBuffer(buffer uint32, data interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if !v.IsValid() {
+		return errors.New("nil data")
+	}
+	size, err := std140.checkGoLayout(v.Type())
+	if err != nil {
+		return err
+	}
+	if goSize := int(v.Type().Size()); goSize < size {
+		size = goSize
+	}
+	if size == 0 {
+		return nil
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+
+	prev := boundBuffer(gl.UNIFORM_BUFFER)
+	defer gl.BindBuffer(gl.UNIFORM_BUFFER, prev)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, buffer)
+	gl.BufferSubData(gl.UNIFORM_BUFFER, 0, size, unsafe.Pointer(p.Pointer()))
+	return nil
+}
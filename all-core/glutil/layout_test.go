@@ -0,0 +1,246 @@
+package glutil
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+type layoutLight struct {
+	Position  [3]float32
+	Intensity float32
+	Color     [3]float32
+}
+
+var layoutTests = []struct {
+	name                   string
+	sample                 interface{}
+	std140, std430         int
+	offsets140, offsets430 map[string]int
+}{
+	{
+		name: "vec3 padding, scalar array, nested struct, mat2x4",
+		sample: struct {
+			A float32
+			B [3]float32
+			C float32
+			D [5]float32
+			L layoutLight
+			E [2][4]float32
+		}{},
+		std140: 176,
+		std430: 128,
+		offsets140: map[string]int{
+			"A": 0, "B": 16, "C": 28, "D": 32, "E": 144,
+			"L": 112, "L.Position": 112, "L.Intensity": 124, "L.Color": 128,
+		},
+		offsets430: map[string]int{
+			"A": 0, "B": 16, "C": 28, "D": 32, "E": 96,
+			"L": 64, "L.Position": 64, "L.Intensity": 76, "L.Color": 80,
+		},
+	},
+	{
+		name: "tightly packed scalar array",
+		sample: &struct {
+			A float32
+			D [5]float32
+			B [3]float32
+			C float32
+		}{},
+		std140:     112,
+		std430:     48,
+		offsets140: map[string]int{"A": 0, "D": 16, "B": 96, "C": 108},
+		offsets430: map[string]int{"A": 0, "D": 4, "B": 32, "C": 44},
+	},
+	{
+		name: "vec4 array",
+		sample: struct {
+			A float32
+			V [5][4]float32
+		}{},
+		std140:     96,
+		std430:     96,
+		offsets140: map[string]int{"A": 0, "V": 16},
+		offsets430: map[string]int{"A": 0, "V": 16},
+	},
+	{
+		name: "struct array and mat3x2",
+		sample: struct {
+			Count  int32
+			Lights [2]layoutLight
+			M      [3][2]float32
+		}{},
+		std140: 128,
+		std430: 112,
+		offsets140: map[string]int{
+			"Count": 0, "Lights": 16, "M": 80,
+			"Lights[0].Position": 16, "Lights[0].Intensity": 28, "Lights[0].Color": 32,
+			"Lights[1].Position": 48, "Lights[1].Intensity": 60, "Lights[1].Color": 64,
+		},
+		offsets430: map[string]int{
+			"Count": 0, "Lights": 16, "M": 80,
+			"Lights[0].Position": 16, "Lights[0].Intensity": 28, "Lights[0].Color": 32,
+			"Lights[1].Position": 48, "Lights[1].Intensity": 60, "Lights[1].Color": 64,
+		},
+	},
+	{
+		name: "doubles and padding fields",
+		sample: struct {
+			X float32
+			_ [7]float32
+			D [3]float64
+			Y float64
+		}{},
+		std140:     64,
+		std430:     64,
+		offsets140: map[string]int{"X": 0, "D": 32, "Y": 56},
+		offsets430: map[string]int{"X": 0, "D": 32, "Y": 56},
+	},
+}
+
+func TestStdLayout(t *testing.T) {
+	for _, tt := range layoutTests {
+		for _, rules := range []struct {
+			name    string
+			layout  func(interface{}) (int, map[string]int, error)
+			size    int
+			offsets map[string]int
+		}{
+			{"Std140Layout", Std140Layout, tt.std140, tt.offsets140},
+			{"Std430Layout", Std430Layout, tt.std430, tt.offsets430},
+		} {
+			size, offsets, err := rules.layout(tt.sample)
+			if err != nil {
+				t.Errorf("%s: %s: %v", tt.name, rules.name, err)
+				continue
+			}
+			if size != rules.size {
+				t.Errorf("%s: %s size = %d, want %d", tt.name, rules.name, size, rules.size)
+			}
+			if !reflect.DeepEqual(offsets, rules.offsets) {
+				t.Errorf("%s: %s offsets = %v, want %v", tt.name, rules.name, offsets, rules.offsets)
+			}
+		}
+	}
+}
+
+func TestStdLayoutErrors(t *testing.T) {
+	for _, sample := range []interface{}{
+		nil,
+		1.0,
+		[4]float32{},
+		struct{ B bool }{},
+		struct{ S struct{ I int } }{},
+	} {
+		if _, _, err := Std140Layout(sample); err == nil {
+			t.Errorf("Std140Layout(%#v) succeeded, want error", sample)
+		}
+		if _, _, err := Std430Layout(sample); err == nil {
+			t.Errorf("Std430Layout(%#v) succeeded, want error", sample)
+		}
+	}
+}
+
+// uniformBlock mirrors uniformBlockShader's Block with explicit std140
+// padding.
+type uniformBlock struct {
+	Color   [3]float32
+	Scale   float32
+	Model   [4][4]float32
+	Count   int32
+	_       [3]int32
+	Weights [2][4]float32
+}
+
+const uniformBlockShader = `#version 330 core
+layout(std140) uniform Block {
+	vec3 color;
+	float scale;
+	mat4 model;
+	int count;
+	mat2x4 weights;
+};
+void main() {
+	gl_Position = model * vec4(color * scale, float(count)) + weights[0] + weights[1];
+}
+`
+
+func TestUpdateUniformBuffer(t *testing.T) {
+	defer testContext(t)()
+	program, err := NewProgram(uniformBlockShader, emptyFragmentShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+
+	size, offsets, err := Std140Layout(uniformBlock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := gl.GetUniformBlockIndex(program, gl.Str("Block\x00"))
+	var driverSize int32
+	gl.GetActiveUniformBlockiv(program, block, gl.UNIFORM_BLOCK_DATA_SIZE, &driverSize)
+	if int(driverSize) != size {
+		t.Errorf("Std140Layout size = %d, driver reports %d", size, driverSize)
+	}
+	for glsl, field := range map[string]string{
+		"color":   "Color",
+		"scale":   "Scale",
+		"model":   "Model",
+		"count":   "Count",
+		"weights": "Weights",
+	} {
+		name, free := gl.Strs(glsl + "\x00")
+		var index uint32
+		gl.GetUniformIndices(program, 1, name, &index)
+		free()
+		var offset int32
+		gl.GetActiveUniformsiv(program, 1, &index, gl.UNIFORM_OFFSET, &offset)
+		if offsets[field] != int(offset) {
+			t.Errorf("Std140Layout offset of %s = %d, driver reports %d", field, offsets[field], offset)
+		}
+	}
+
+	data := uniformBlock{
+		Color:   [3]float32{1, 2, 3},
+		Scale:   4,
+		Model:   [4][4]float32{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {5, 6, 7, 1}},
+		Count:   8,
+		Weights: [2][4]float32{{9, 10, 11, 12}, {13, 14, 15, 16}},
+	}
+	var buffer uint32
+	gl.GenBuffers(1, &buffer)
+	defer gl.DeleteBuffers(1, &buffer)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, buffer)
+	gl.BufferData(gl.UNIFORM_BUFFER, size, nil, gl.DYNAMIC_DRAW)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, 0)
+
+	if err := UpdateUniformBuffer(buffer, &data); err != nil {
+		t.Fatalf("UpdateUniformBuffer: %v", err)
+	}
+	if got := boundBuffer(gl.UNIFORM_BUFFER); got != 0 {
+		t.Errorf("UNIFORM_BUFFER binding = %d after UpdateUniformBuffer, want 0", got)
+	}
+	got := make([]byte, size)
+	gl.BindBuffer(gl.UNIFORM_BUFFER, buffer)
+	gl.GetBufferSubData(gl.UNIFORM_BUFFER, 0, size, gl.Ptr(got))
+	gl.BindBuffer(gl.UNIFORM_BUFFER, 0)
+	want := (*[unsafe.Sizeof(data)]byte)(unsafe.Pointer(&data))[:]
+	if !bytes.Equal(got, want) {
+		t.Errorf("buffer contents = %v, want %v", got, want)
+	}
+	checkErrors(t)
+
+	var unpadded struct {
+		A, B [3]float32
+	}
+	if err := UpdateUniformBuffer(buffer, unpadded); err == nil {
+		t.Error("UpdateUniformBuffer accepted a vec3 pair without padding")
+	}
+	if err := UpdateUniformBuffer(buffer, nil); err == nil {
+		t.Error("UpdateUniformBuffer accepted nil data")
+	}
+}
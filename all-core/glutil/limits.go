@@ -0,0 +1,185 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// floatRange queries state made up of a minimum and maximum float value.
+func floatRange(pname uint32) (min, max float32) {
+	var r [2]float32
+	gl.GetFloatv(pname, &r[0])
+	return r[0], r[1]
+}
+
+// LineWidthRange returns the range of widths supported for lines drawn without
+// line smoothing. Core profile contexts are only required to support a width
+// of 1.
+func LineWidthRange() (min, max float32) {
+	return floatRange(gl.ALIASED_LINE_WIDTH_RANGE)
+}
+
+// SmoothLineWidthRange returns the range of widths supported for antialiased
+// lines.
+func SmoothLineWidthRange() (min, max float32) {
+	return floatRange(gl.SMOOTH_LINE_WIDTH_RANGE)
+}
+
+// PointSizeRange returns the range of supported point sizes.
+func PointSizeRange() (min, max float32) {
+	return floatRange(gl.POINT_SIZE_RANGE)
+}
+
+// MaxSamples returns the maximum number of samples supported for multisample
+// renderbuffers. OpenGL 3.0 and later guarantee at least 4.
+func MaxSamples() int32 {
+	return getInteger(gl.MAX_SAMPLES)
+}
+
+// MaxColorTextureSamples returns the maximum number of samples supported for
+// multisample color textures.
+func MaxColorTextureSamples() int32 {
+	return getInteger(gl.MAX_COLOR_TEXTURE_SAMPLES)
+}
+
+// MaxDepthTextureSamples returns the maximum number of samples supported for
+// multisample depth and stencil textures.
+func MaxDepthTextureSamples() int32 {
+	return getInteger(gl.MAX_DEPTH_TEXTURE_SAMPLES)
+}
+
+// MaxArrayLayers returns the maximum number of layers of an array texture.
+// OpenGL 3.0 and later guarantee at least 256 and OpenGL 4.5 at least 2048.
+func MaxArrayLayers() int32 {
+	return getInteger(gl.MAX_ARRAY_TEXTURE_LAYERS)
+}
+
+// Max3DTextureSize returns the maximum width, height, and depth of a 3D
+// texture. OpenGL 3.0 and later guarantee at least 256 and OpenGL 4.5 at least
+// 2048.
+func Max3DTextureSize() int32 {
+	return getInteger(gl.MAX_3D_TEXTURE_SIZE)
+}
+
+// MaxDrawBuffers returns the maximum number of draw buffers fragment outputs
+// can be written to at once. OpenGL 3.0 and later guarantee at least 8.
+func MaxDrawBuffers() int32 {
+	return getInteger(gl.MAX_DRAW_BUFFERS)
+}
+
+// MaxColorAttachments returns the maximum number of color attachments of a
+// framebuffer, so gl.COLOR_ATTACHMENT0+MaxColorAttachments()-1 is the last
+// usable one. OpenGL 3.0 and later guarantee at least 8.
+func MaxColorAttachments() int32 {
+	return getInteger(gl.MAX_COLOR_ATTACHMENTS)
+}
+
+// MaxVertexUniformComponents returns the maximum number of scalar components
+// of uniforms outside uniform blocks that a vertex shader can use. OpenGL 3.2
+// and later guarantee at least 1024.
+func MaxVertexUniformComponents() int32 {
+	return getInteger(gl.MAX_VERTEX_UNIFORM_COMPONENTS)
+}
+
+// MaxFragmentUniformComponents returns the maximum number of scalar components
+// of uniforms outside uniform blocks that a fragment shader can use. OpenGL
+// 3.2 and later guarantee at least 1024.
+func MaxFragmentUniformComponents() int32 {
+	return getInteger(gl.MAX_FRAGMENT_UNIFORM_COMPONENTS)
+}
+
+// MaxUniformLocations returns the maximum number of uniform locations a
+// program can use, counting each array element separately. The limit was
+// introduced with OpenGL 4.3, which guarantees at least 1024; on older
+// contexts MaxUniformLocations returns zero.
+func MaxUniformLocations() int32 {
+	if !versionAtLeast(4, 3) && !extensionSupported("GL_ARB_explicit_uniform_location") {
+		return 0
+	}
+	return getInteger(gl.MAX_UNIFORM_LOCATIONS)
+}
+
+// Limits holds implementation limits of the current context, as returned by
+// QueryLimits. Each field is documented by the function of the same name.
+type Limits struct {
+	MaxSamples             int32
+	MaxColorTextureSamples int32
+	MaxDepthTextureSamples int32
+	MaxArrayLayers         int32
+	Max3DTextureSize       int32
+	MaxDrawBuffers         int32
+	MaxColorAttachments    int32
+
+	MaxVertexUniformComponents   int32
+	MaxFragmentUniformComponents int32
+	MaxUniformLocations          int32
+}
+
+// QueryLimits returns all limits of the current context at once, for example
+// to log them at startup.
+func QueryLimits() Limits {
+	return Limits{
+		MaxSamples:             MaxSamples(),
+		MaxColorTextureSamples: MaxColorTextureSamples(),
+		MaxDepthTextureSamples: MaxDepthTextureSamples(),
+		MaxArrayLayers:         MaxArrayLayers(),
+		Max3DTextureSize:       Max3DTextureSize(),
+		MaxDrawBuffers:         MaxDrawBuffers(),
+		MaxColorAttachments:    MaxColorAttachments(),
+
+		MaxVertexUniformComponents:   MaxVertexUniformComponents(),
+		MaxFragmentUniformComponents: MaxFragmentUniformComponents(),
+		MaxUniformLocations:          MaxUniformLocations(),
+	}
+}
+
+// ClampArrayLayers limits a layer count to [1, MaxArrayLayers].
+func ClampArrayLayers(layers int32) int32 {
+	return clampInt32(layers, 1, MaxArrayLayers())
+}
+
+// Clamp3DTextureSize limits a 3D texture dimension to [1, Max3DTextureSize].
+func Clamp3DTextureSize(size int32) int32 {
+	return clampInt32(size, 1, Max3DTextureSize())
+}
+
+func clampInt32(v, min, max int32) int32 {
+	if v > max {
+		v = max
+	}
+	if v < min {
+		v = min
+	}
+	return v
+}
+
+// ComputeLimits holds the compute shader limits of the current context, as
+// returned by QueryComputeLimits.
+type ComputeLimits struct {
+	// MaxInvocations is the maximum total number of invocations in a work
+	// group, that is the product of the local sizes. At least 1024.
+	MaxInvocations int32
+	// MaxSize is the maximum local size in each dimension, at least 1024,
+	// 1024, and 64.
+	MaxSize [3]int32
+	// MaxCount is the maximum number of work groups per dispatch in each
+	// dimension, at least 65535.
+	MaxCount [3]int32
+	// MaxSharedMemory is the maximum size in bytes of shared variables of a
+	// work group, at least 32768.
+	MaxSharedMemory int32
+}
+
+// QueryComputeLimits returns the limits that constrain the local size and
+// dispatch size of compute shaders. Compute shaders require OpenGL 4.3 or
+// GL_ARB_compute_shader; without them all limits are zero.
+func QueryComputeLimits() ComputeLimits {
+	var l ComputeLimits
+	if !versionAtLeast(4, 3) && !extensionSupported("GL_ARB_compute_shader") {
+		return l
+	}
+	l.MaxInvocations = getInteger(gl.MAX_COMPUTE_WORK_GROUP_INVOCATIONS)
+	for i := uint32(0); i < 3; i++ {
+		l.MaxSize[i] = GetIntegerIndexed(gl.MAX_COMPUTE_WORK_GROUP_SIZE, i)
+		l.MaxCount[i] = GetIntegerIndexed(gl.MAX_COMPUTE_WORK_GROUP_COUNT, i)
+	}
+	l.MaxSharedMemory = getInteger(gl.MAX_COMPUTE_SHARED_MEMORY_SIZE)
+	return l
+}
@@ -0,0 +1,98 @@
+package glutil
+
+import "testing"
+
+func TestRasterSizeRanges(t *testing.T) {
+	defer testContext(t)()
+	for _, r := range []struct {
+		name     string
+		rangeFn  func() (min, max float32)
+		required float32
+	}{
+		{"LineWidthRange", LineWidthRange, 1},
+		{"SmoothLineWidthRange", SmoothLineWidthRange, 1},
+		{"PointSizeRange", PointSizeRange, 1},
+	} {
+		min, max := r.rangeFn()
+		if min <= 0 || max < min || max < r.required {
+			t.Errorf("%s() = %v, %v; want 0 < min <= max and max >= %v", r.name, min, max, r.required)
+		}
+	}
+	checkErrors(t)
+}
+
+func TestDrawBufferLimits(t *testing.T) {
+	defer testContext(t)()
+	if max := MaxDrawBuffers(); max < 8 {
+		t.Errorf("MaxDrawBuffers() = %d, want at least 8", max)
+	}
+	if max := MaxColorAttachments(); max < 8 {
+		t.Errorf("MaxColorAttachments() = %d, want at least 8", max)
+	}
+	limits := QueryLimits()
+	if limits.MaxDrawBuffers != MaxDrawBuffers() || limits.MaxColorAttachments != MaxColorAttachments() {
+		t.Errorf("QueryLimits() = %+v, inconsistent with MaxDrawBuffers and MaxColorAttachments", limits)
+	}
+	checkErrors(t)
+}
+
+func TestSampleLimits(t *testing.T) {
+	defer testContext(t)()
+	if max := MaxSamples(); max < 4 {
+		t.Errorf("MaxSamples() = %d, want at least 4", max)
+	}
+	if max := MaxColorTextureSamples(); max < 1 {
+		t.Errorf("MaxColorTextureSamples() = %d, want at least 1", max)
+	}
+	if max := MaxDepthTextureSamples(); max < 1 {
+		t.Errorf("MaxDepthTextureSamples() = %d, want at least 1", max)
+	}
+	checkErrors(t)
+}
+
+func TestTextureSizeLimits(t *testing.T) {
+	defer testContext(t)()
+	min := int32(256)
+	if versionAtLeast(4, 5) {
+		min = 2048
+	}
+	if max := MaxArrayLayers(); max < min {
+		t.Errorf("MaxArrayLayers() = %d, want at least %d", max, min)
+	}
+	if max := Max3DTextureSize(); max < min {
+		t.Errorf("Max3DTextureSize() = %d, want at least %d", max, min)
+	}
+	if got := ClampArrayLayers(1 << 30); got != MaxArrayLayers() {
+		t.Errorf("ClampArrayLayers(1<<30) = %d, want %d", got, MaxArrayLayers())
+	}
+	if got := Clamp3DTextureSize(0); got != 1 {
+		t.Errorf("Clamp3DTextureSize(0) = %d, want 1", got)
+	}
+	checkErrors(t)
+}
+
+func TestComputeLimits(t *testing.T) {
+	defer testContext(t)()
+	if !versionAtLeast(4, 3) && !extensionSupported("GL_ARB_compute_shader") {
+		if l := QueryComputeLimits(); l != (ComputeLimits{}) {
+			t.Errorf("QueryComputeLimits() = %+v without compute shaders, want zero limits", l)
+		}
+		t.Skip("requires OpenGL 4.3 or GL_ARB_compute_shader")
+	}
+	l := QueryComputeLimits()
+	checkErrors(t)
+	if l.MaxInvocations < 1024 {
+		t.Errorf("MaxInvocations = %d, want at least 1024", l.MaxInvocations)
+	}
+	for i, min := range [3]int32{1024, 1024, 64} {
+		if l.MaxSize[i] < min {
+			t.Errorf("MaxSize[%d] = %d, want at least %d", i, l.MaxSize[i], min)
+		}
+		if l.MaxCount[i] < 65535 {
+			t.Errorf("MaxCount[%d] = %d, want at least 65535", i, l.MaxCount[i])
+		}
+	}
+	if l.MaxSharedMemory < 32768 {
+		t.Errorf("MaxSharedMemory = %d, want at least 32768", l.MaxSharedMemory)
+	}
+}
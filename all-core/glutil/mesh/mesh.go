@@ -0,0 +1,80 @@
+// Package mesh provides a drawable handle for indexed vertex data, tying
+// together vertex arrays, buffers, and glutil attribute layouts.
+//
+// Like glutil, it is built on the all-core bindings and requires gl.Init to
+// have been called under an active OpenGL context.
+package mesh
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+)
+
+// Mesh is vertex data uploaded to the GPU along with its vertex array, ready
+// to be drawn.
+type Mesh struct {
+	vao, vbo, ebo uint32
+	count         int32 // Number of indices, or vertices if not indexed.
+}
+
+// NewMesh uploads vertices, which must be a slice such as a []float32 or a
+// slice of vertex structs, and indices to new buffers and creates a vertex
+// array with the attributes of layout reading from the vertex buffer. If
+// indices is empty the mesh is drawn without indices, using every vertex in
+// order.
+//
+// The previous vertex array and buffer bindings are restored on return.
+// NewMesh panics if vertices is not a slice.
+func NewMesh(vertices interface{}, indices []uint32, layout []glutil.AttribSpec) *Mesh {
+	v := reflect.ValueOf(vertices)
+	if v.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("NewMesh: unsupported vertex type %T; must be a slice", vertices))
+	}
+	size := v.Len() * int(v.Type().Elem().Size())
+
+	saved := glutil.SavePipeline()
+	defer saved.Restore()
+
+	m := &Mesh{count: int32(v.Len())}
+	gl.GenVertexArrays(1, &m.vao)
+	gl.BindVertexArray(m.vao)
+
+	gl.GenBuffers(1, &m.vbo)
+	gl.BindBuffer(gl.ARRAY_BUFFER, m.vbo)
+	if size > 0 {
+		gl.BufferData(gl.ARRAY_BUFFER, size, gl.Ptr(vertices), gl.STATIC_DRAW)
+	}
+	glutil.ApplyLayout(layout)
+
+	if len(indices) > 0 {
+		gl.GenBuffers(1, &m.ebo)
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, m.ebo)
+		gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, 4*len(indices), gl.Ptr(indices), gl.STATIC_DRAW)
+		m.count = int32(len(indices))
+	}
+	return m
+}
+
+// Draw draws the mesh with the current program as primitives of the given
+// mode, such as gl.TRIANGLES. The mesh's vertex array is left bound.
+func (m *Mesh) Draw(mode uint32) {
+	gl.BindVertexArray(m.vao)
+	if m.ebo != 0 {
+		gl.DrawElementsWithOffset(mode, m.count, gl.UNSIGNED_INT, 0)
+	} else {
+		gl.DrawArrays(mode, 0, m.count)
+	}
+}
+
+// Delete deletes the mesh's vertex array and buffers.
+func (m *Mesh) Delete() {
+	gl.DeleteVertexArrays(1, &m.vao)
+	gl.DeleteBuffers(1, &m.vbo)
+	if m.ebo != 0 {
+		gl.DeleteBuffers(1, &m.ebo)
+	}
+	*m = Mesh{}
+}
@@ -0,0 +1,108 @@
+package mesh
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/gl/all-core/glutil"
+	"github.com/go-gl/gl/internal/gltest"
+)
+
+// testContext makes the shared headless test context current for the calling
+// test, skipping the test if no OpenGL context can be created. The returned
+// function must be deferred:
+//
+//	defer testContext(t)()
+func testContext(t *testing.T) (release func()) {
+	t.Helper()
+	release, err := gltest.Acquire()
+	if err != nil {
+		t.Skipf("no OpenGL context: %v", err)
+	}
+	return release
+}
+
+// checkErrors fails the test if OpenGL recorded any errors.
+func checkErrors(t *testing.T) {
+	t.Helper()
+	for _, code := range glutil.GetErrors() {
+		t.Errorf("GL error %s", glutil.ErrorName(code))
+	}
+}
+
+const positionShader = `#version 330 core
+layout(location = 0) in vec2 position;
+void main() {
+	gl_Position = vec4(position, 0.0, 1.0);
+}
+`
+
+const whiteShader = `#version 330 core
+out vec4 fragColor;
+void main() {
+	fragColor = vec4(1.0);
+}
+`
+
+type vertex struct {
+	X, Y float32
+}
+
+func TestMeshDraw(t *testing.T) {
+	defer testContext(t)()
+	program, err := glutil.NewProgram(positionShader, whiteShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+
+	var fbo, rb uint32
+	gl.GenRenderbuffers(1, &rb)
+	defer gl.DeleteRenderbuffers(1, &rb)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb)
+	gl.RenderbufferStorage(gl.RENDERBUFFER, gl.RGBA8, 4, 4)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+	gl.GenFramebuffers(1, &fbo)
+	defer gl.DeleteFramebuffers(1, &fbo)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	defer gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.RENDERBUFFER, rb)
+	var viewport [4]int32
+	gl.GetIntegerv(gl.VIEWPORT, &viewport[0])
+	defer gl.Viewport(viewport[0], viewport[1], viewport[2], viewport[3])
+	gl.Viewport(0, 0, 4, 4)
+
+	// A quad covering the left half of the framebuffer.
+	vertices := []vertex{{-1, -1}, {0, -1}, {0, 1}, {-1, 1}}
+	layout := []glutil.AttribSpec{{Index: 0, Size: 2, Type: gl.FLOAT, Stride: 8}}
+	m := NewMesh(vertices, []uint32{0, 1, 2, 0, 2, 3}, layout)
+	defer m.Delete()
+	var vao int32
+	gl.GetIntegerv(gl.VERTEX_ARRAY_BINDING, &vao)
+	if vao != 0 {
+		t.Errorf("vertex array binding after NewMesh = %d, want 0", vao)
+	}
+
+	gl.ClearColor(0, 0, 0, 0)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.UseProgram(program)
+	m.Draw(gl.TRIANGLES)
+	gl.UseProgram(0)
+	gl.BindVertexArray(0)
+
+	pixels := make([]byte, 4*4*4)
+	gl.ReadPixels(0, 0, 4, 4, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			want := []byte{0, 0, 0, 0}
+			if x < 2 {
+				want = []byte{255, 255, 255, 255}
+			}
+			if got := pixels[4*(4*y+x):][:4]; !bytes.Equal(got, want) {
+				t.Errorf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+	checkErrors(t)
+}
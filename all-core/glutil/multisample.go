@@ -0,0 +1,56 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// NewMultisampleColorTexture creates a gl.TEXTURE_2D_MULTISAMPLE texture with
+// fixed sample locations. The sample count is clamped to
+// MaxColorTextureSamples. The previously bound multisample texture is
+// restored on return.
+func NewMultisampleColorTexture(samples, internalFormat, width, height int32) uint32 {
+	samples = clampInt32(samples, 1, MaxColorTextureSamples())
+
+	var prev int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D_MULTISAMPLE, &prev)
+	defer gl.BindTexture(gl.TEXTURE_2D_MULTISAMPLE, uint32(prev))
+
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D_MULTISAMPLE, tex)
+	gl.TexImage2DMultisample(gl.TEXTURE_2D_MULTISAMPLE, samples, uint32(internalFormat), width, height, true)
+	return tex
+}
+
+// NewMultisampleRenderbuffer creates a multisample renderbuffer. The sample
+// count is clamped to MaxSamples. The previously bound renderbuffer is
+// restored on return.
+func NewMultisampleRenderbuffer(samples, internalFormat, width, height int32) uint32 {
+	samples = clampInt32(samples, 1, MaxSamples())
+
+	var prev int32
+	gl.GetIntegerv(gl.RENDERBUFFER_BINDING, &prev)
+	defer gl.BindRenderbuffer(gl.RENDERBUFFER, uint32(prev))
+
+	var rb uint32
+	gl.GenRenderbuffers(1, &rb)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb)
+	gl.RenderbufferStorageMultisample(gl.RENDERBUFFER, samples, uint32(internalFormat), width, height)
+	return rb
+}
+
+// SamplePositions returns the subpixel positions of the samples of the draw
+// framebuffer, in the range [0, 1] within a pixel. It returns nil if the
+// framebuffer is not multisampled or the context is older than OpenGL 3.2.
+func SamplePositions() [][2]float32 {
+	if !versionAtLeast(3, 2) {
+		return nil
+	}
+	samples := getInteger(gl.SAMPLES)
+	if samples <= 0 {
+		return nil
+	}
+	positions := make([][2]float32, samples)
+	for i := range positions {
+		gl.GetMultisamplefv(gl.SAMPLE_POSITION, uint32(i), &positions[i][0])
+	}
+	return positions
+}
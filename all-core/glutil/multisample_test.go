@@ -0,0 +1,74 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestMultisampleAttachments(t *testing.T) {
+	defer testContext(t)()
+	samples := clampInt32(4, 1, MaxColorTextureSamples())
+	tex := NewMultisampleColorTexture(samples, gl.RGBA8, 8, 8)
+	defer gl.DeleteTextures(1, &tex)
+	rb := NewMultisampleRenderbuffer(samples, gl.DEPTH24_STENCIL8, 8, 8)
+	defer gl.DeleteRenderbuffers(1, &rb)
+
+	var fbo uint32
+	gl.GenFramebuffers(1, &fbo)
+	defer gl.DeleteFramebuffers(1, &fbo)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	defer gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D_MULTISAMPLE, tex, 0)
+	gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.DEPTH_STENCIL_ATTACHMENT, gl.RENDERBUFFER, rb)
+	if status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); status != gl.FRAMEBUFFER_COMPLETE {
+		t.Errorf("multisample framebuffer is incomplete: status 0x%X", status)
+	}
+	checkErrors(t)
+}
+
+func TestNewMultisampleRenderbufferClamps(t *testing.T) {
+	defer testContext(t)()
+	rb := NewMultisampleRenderbuffer(MaxSamples()+1, gl.RGBA8, 8, 8)
+	defer gl.DeleteRenderbuffers(1, &rb)
+	checkErrors(t)
+
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb)
+	defer gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+	var samples int32
+	gl.GetRenderbufferParameteriv(gl.RENDERBUFFER, gl.RENDERBUFFER_SAMPLES, &samples)
+	if samples < 1 || samples > MaxSamples() {
+		t.Errorf("renderbuffer has %d samples, want 1 to %d", samples, MaxSamples())
+	}
+}
+
+func TestSamplePositions(t *testing.T) {
+	defer testContext(t)()
+	if positions := SamplePositions(); positions != nil {
+		t.Errorf("SamplePositions() = %v for the single-sampled default framebuffer, want nil", positions)
+	}
+
+	tex := NewMultisampleColorTexture(4, gl.RGBA8, 8, 8)
+	defer gl.DeleteTextures(1, &tex)
+	var fbo uint32
+	gl.GenFramebuffers(1, &fbo)
+	defer gl.DeleteFramebuffers(1, &fbo)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	defer gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D_MULTISAMPLE, tex, 0)
+
+	var samples int32
+	gl.BindTexture(gl.TEXTURE_2D_MULTISAMPLE, tex)
+	gl.GetTexLevelParameteriv(gl.TEXTURE_2D_MULTISAMPLE, 0, gl.TEXTURE_SAMPLES, &samples)
+	gl.BindTexture(gl.TEXTURE_2D_MULTISAMPLE, 0)
+	positions := SamplePositions()
+	if len(positions) != int(samples) {
+		t.Fatalf("SamplePositions() returned %d positions for %d samples", len(positions), samples)
+	}
+	for i, p := range positions {
+		if p[0] < 0 || p[0] > 1 || p[1] < 0 || p[1] > 1 {
+			t.Errorf("sample %d is at %v, outside the pixel", i, p)
+		}
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,106 @@
+package glutil
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// separateShaderObjectsSupported reports whether program pipeline objects are
+// available.
+func separateShaderObjectsSupported() bool {
+	return versionAtLeast(4, 1) || extensionSupported("GL_ARB_separate_shader_objects")
+}
+
+// NewPipeline creates a program pipeline object, which combines the stages of
+// separable programs without linking them together. Bind it with
+// glBindProgramPipeline while no program is current with glUseProgram.
+//
+// NewPipeline returns zero before OpenGL 4.1 unless
+// GL_ARB_separate_shader_objects is supported.
+func NewPipeline() uint32 {
+	if !separateShaderObjectsSupported() {
+		return 0
+	}
+	var pipeline uint32
+	gl.GenProgramPipelines(1, &pipeline)
+	return pipeline
+}
+
+// UseProgramStages makes pipeline use the stages of the separable program
+// selected by stages, a bitmask such as
+// gl.VERTEX_SHADER_BIT|gl.FRAGMENT_SHADER_BIT. A program of zero removes the
+// stages from the pipeline. It is a no-op if program pipelines are not
+// supported.
+func UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	if !separateShaderObjectsSupported() {
+		return
+	}
+	gl.UseProgramStages(pipeline, stages, program)
+}
+
+// PipelineInfoLog returns the information log of a program pipeline object,
+// which holds the messages of its last validation. It returns an empty string
+// if program pipelines are not supported.
+func PipelineInfoLog(pipeline uint32) string {
+	if !separateShaderObjectsSupported() {
+		return ""
+	}
+	var length int32
+	gl.GetProgramPipelineiv(pipeline, gl.INFO_LOG_LENGTH, &length)
+	if length == 0 {
+		return ""
+	}
+	log := make([]uint8, length)
+	gl.GetProgramPipelineInfoLog(pipeline, length, nil, &log[0])
+	return strings.TrimRight(string(log), "\x00")
+}
+
+// ValidatePipeline checks whether pipeline can execute given the current GL
+// state, for example that the outputs of each stage match the inputs of the
+// next. If validation fails the returned error holds the pipeline's
+// information log.
+func ValidatePipeline(pipeline uint32) error {
+	if !separateShaderObjectsSupported() {
+		return requireVersion("glValidateProgramPipeline", 4, 1)
+	}
+	gl.ValidateProgramPipeline(pipeline)
+	var status int32
+	gl.GetProgramPipelineiv(pipeline, gl.VALIDATE_STATUS, &status)
+	if status == gl.FALSE {
+		log := PipelineInfoLog(pipeline)
+		if log == "" {
+			log = "program pipeline validation failed"
+		}
+		return errors.New(log)
+	}
+	return nil
+}
+
+// NewSeparableProgram compiles source as a shader of the given stage, such as
+// gl.VERTEX_SHADER, and links it into a separable program for use with
+// UseProgramStages. If compiling or linking fails the program is deleted and
+// the returned error holds its information log.
+func NewSeparableProgram(stage uint32, source string) (uint32, error) {
+	if !separateShaderObjectsSupported() {
+		return 0, requireVersion("glCreateShaderProgramv", 4, 1)
+	}
+	csource, free := gl.Strs(source + "\x00")
+	program := gl.CreateShaderProgramv(stage, 1, csource)
+	free()
+	if program == 0 {
+		return 0, errors.New("glCreateShaderProgramv failed")
+	}
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+	if status == gl.FALSE {
+		log := ProgramInfoLog(program)
+		gl.DeleteProgram(program)
+		if log == "" {
+			log = "separable program link failed"
+		}
+		return 0, errors.New(log)
+	}
+	return program, nil
+}
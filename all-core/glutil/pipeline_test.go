@@ -0,0 +1,90 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// separableVertexShader is fullscreenVertexShader with the gl_PerVertex
+// redeclaration that separable programs need.
+const separableVertexShader = `#version 410 core
+out gl_PerVertex {
+	vec4 gl_Position;
+};
+void main() {
+	vec2 pos = vec2(gl_VertexID & 1, gl_VertexID >> 1) * 4.0 - 1.0;
+	gl_Position = vec4(pos, 0.0, 1.0);
+}
+`
+
+// requireSeparateShaderObjects skips the test if program pipelines are not
+// supported.
+func requireSeparateShaderObjects(t *testing.T) {
+	t.Helper()
+	if !separateShaderObjectsSupported() {
+		t.Skip("requires OpenGL 4.1 or GL_ARB_separate_shader_objects")
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	defer testContext(t)()
+	requireSeparateShaderObjects(t)
+	vao := bindEmptyVAO()
+	defer vao()
+	vs, err := NewSeparableProgram(gl.VERTEX_SHADER, separableVertexShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(vs)
+	fs, err := NewSeparableProgram(gl.FRAGMENT_SHADER, emptyFragmentShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(fs)
+
+	pipeline := NewPipeline()
+	if pipeline == 0 {
+		t.Fatal("NewPipeline returned zero")
+	}
+	defer gl.DeleteProgramPipelines(1, &pipeline)
+	UseProgramStages(pipeline, gl.VERTEX_SHADER_BIT, vs)
+	UseProgramStages(pipeline, gl.FRAGMENT_SHADER_BIT, fs)
+	gl.BindProgramPipeline(pipeline)
+	defer gl.BindProgramPipeline(0)
+	if err := ValidatePipeline(pipeline); err != nil {
+		t.Errorf("ValidatePipeline: %v", err)
+	}
+	var stage int32
+	gl.GetProgramPipelineiv(pipeline, gl.VERTEX_SHADER, &stage)
+	if uint32(stage) != vs {
+		t.Errorf("pipeline vertex stage = %d, want %d", stage, vs)
+	}
+	gl.GetProgramPipelineiv(pipeline, gl.FRAGMENT_SHADER, &stage)
+	if uint32(stage) != fs {
+		t.Errorf("pipeline fragment stage = %d, want %d", stage, fs)
+	}
+	checkErrors(t)
+}
+
+func TestNewSeparableProgram(t *testing.T) {
+	defer testContext(t)()
+	requireSeparateShaderObjects(t)
+	program, err := NewSeparableProgram(gl.VERTEX_SHADER, separableVertexShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	var separable int32
+	gl.GetProgramiv(program, gl.PROGRAM_SEPARABLE, &separable)
+	if separable != gl.TRUE {
+		t.Error("PROGRAM_SEPARABLE is not set")
+	}
+
+	if _, err := NewSeparableProgram(gl.VERTEX_SHADER, "#version 410 core\nvoid main() { undefined(); }\n"); err == nil {
+		t.Error("NewSeparableProgram succeeded with invalid source")
+	} else if err.Error() == "" {
+		t.Error("NewSeparableProgram returned an empty error")
+	}
+	checkErrors(t)
+}
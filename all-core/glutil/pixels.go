@@ -0,0 +1,115 @@
+package glutil
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// formatComponents returns the number of components of a pixel transfer
+// format such as gl.RGBA.
+func formatComponents(format uint32) (int, error) {
+	switch format {
+	case gl.RED, gl.GREEN, gl.BLUE, gl.RED_INTEGER, gl.GREEN_INTEGER, gl.BLUE_INTEGER,
+		gl.DEPTH_COMPONENT, gl.STENCIL_INDEX:
+		return 1, nil
+	case gl.RG, gl.RG_INTEGER, gl.DEPTH_STENCIL:
+		return 2, nil
+	case gl.RGB, gl.BGR, gl.RGB_INTEGER, gl.BGR_INTEGER:
+		return 3, nil
+	case gl.RGBA, gl.BGRA, gl.RGBA_INTEGER, gl.BGRA_INTEGER:
+		return 4, nil
+	}
+	return 0, fmt.Errorf("unsupported pixel format 0x%X", format)
+}
+
+// PixelSize returns the size in bytes of one pixel transferred with the given
+// format and type, such as gl.RGBA and gl.UNSIGNED_BYTE.
+func PixelSize(format, xtype uint32) (int, error) {
+	switch xtype {
+	case gl.UNSIGNED_BYTE_3_3_2, gl.UNSIGNED_BYTE_2_3_3_REV:
+		return 1, nil
+	case gl.UNSIGNED_SHORT_5_6_5, gl.UNSIGNED_SHORT_5_6_5_REV,
+		gl.UNSIGNED_SHORT_4_4_4_4, gl.UNSIGNED_SHORT_4_4_4_4_REV,
+		gl.UNSIGNED_SHORT_5_5_5_1, gl.UNSIGNED_SHORT_1_5_5_5_REV:
+		return 2, nil
+	case gl.UNSIGNED_INT_8_8_8_8, gl.UNSIGNED_INT_8_8_8_8_REV,
+		gl.UNSIGNED_INT_10_10_10_2, gl.UNSIGNED_INT_2_10_10_10_REV,
+		gl.UNSIGNED_INT_24_8, gl.UNSIGNED_INT_10F_11F_11F_REV, gl.UNSIGNED_INT_5_9_9_9_REV:
+		return 4, nil
+	case gl.FLOAT_32_UNSIGNED_INT_24_8_REV:
+		return 8, nil
+	}
+
+	components, err := formatComponents(format)
+	if err != nil {
+		return 0, err
+	}
+	switch xtype {
+	case gl.UNSIGNED_BYTE, gl.BYTE:
+		return components, nil
+	case gl.UNSIGNED_SHORT, gl.SHORT, gl.HALF_FLOAT:
+		return 2 * components, nil
+	case gl.UNSIGNED_INT, gl.INT, gl.FLOAT:
+		return 4 * components, nil
+	}
+	return 0, fmt.Errorf("unsupported pixel type 0x%X", xtype)
+}
+
+// GetTexImageRobust reads back a level of the texture bound to target, or a
+// single face of a cube map texture when target is one of the
+// gl.TEXTURE_CUBE_MAP_* face targets, as tightly packed rows.
+//
+// The buffer is sized from the level's dimensions, format, and xtype. Where
+// GL_ARB_robustness or OpenGL 4.5 is available glGetnTexImage is used, which
+// is passed the buffer size and never writes past it; otherwise it falls back
+// to glGetTexImage. The ARB entry point is preferred, since some drivers
+// reporting OpenGL 4.5, such as Mesa 22, resolve the core one to a function
+// that reads nothing.
+func GetTexImageRobust(target uint32, level int32, format, xtype uint32) ([]byte, error) {
+	pixelSize, err := PixelSize(format, xtype)
+	if err != nil {
+		return nil, err
+	}
+	var width, height, depth int32
+	gl.GetTexLevelParameteriv(target, level, gl.TEXTURE_WIDTH, &width)
+	gl.GetTexLevelParameteriv(target, level, gl.TEXTURE_HEIGHT, &height)
+	gl.GetTexLevelParameteriv(target, level, gl.TEXTURE_DEPTH, &depth)
+	size := int(width) * int(height) * int(depth) * pixelSize
+	if size == 0 {
+		return nil, fmt.Errorf("texture level %d is empty", level)
+	}
+	data := make([]byte, size)
+
+	saved := SavePixelStore()
+	defer saved.Restore()
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	gl.PixelStorei(gl.PACK_ROW_LENGTH, 0)
+	gl.PixelStorei(gl.PACK_IMAGE_HEIGHT, 0)
+	gl.PixelStorei(gl.PACK_SKIP_PIXELS, 0)
+	gl.PixelStorei(gl.PACK_SKIP_ROWS, 0)
+	gl.PixelStorei(gl.PACK_SKIP_IMAGES, 0)
+
+	packBuffer := boundBuffer(gl.PIXEL_PACK_BUFFER)
+	if packBuffer != 0 {
+		gl.BindBuffer(gl.PIXEL_PACK_BUFFER, 0)
+		defer gl.BindBuffer(gl.PIXEL_PACK_BUFFER, packBuffer)
+	}
+
+	switch {
+	case extensionSupported("GL_ARB_robustness"):
+		gl.GetnTexImageARB(target, level, format, xtype, int32(size), gl.Ptr(data))
+	case versionAtLeast(4, 5):
+		gl.GetnTexImage(target, level, format, xtype, int32(size), gl.Ptr(data))
+	default:
+		gl.GetTexImage(target, level, format, xtype, gl.Ptr(data))
+	}
+	return data, nil
+}
+
+// PreferredReadFormat returns the pixel format and type that glReadPixels
+// handles most efficiently for the read framebuffer, which must be complete.
+// Reading with any other combination may involve a conversion by the driver.
+func PreferredReadFormat() (format, xtype uint32) {
+	return uint32(getInteger(gl.IMPLEMENTATION_COLOR_READ_FORMAT)), uint32(getInteger(gl.IMPLEMENTATION_COLOR_READ_TYPE))
+}
@@ -0,0 +1,110 @@
+package glutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestPixelSize(t *testing.T) {
+	tests := []struct {
+		format, xtype uint32
+		want          int
+	}{
+		{gl.RED, gl.UNSIGNED_BYTE, 1},
+		{gl.RG, gl.BYTE, 2},
+		{gl.RGB, gl.UNSIGNED_BYTE, 3},
+		{gl.BGR, gl.UNSIGNED_BYTE, 3},
+		{gl.RGBA, gl.UNSIGNED_BYTE, 4},
+		{gl.BGRA, gl.UNSIGNED_BYTE, 4},
+		{gl.RGBA, gl.HALF_FLOAT, 8},
+		{gl.RGB, gl.UNSIGNED_SHORT, 6},
+		{gl.RG, gl.FLOAT, 8},
+		{gl.RGBA, gl.FLOAT, 16},
+		{gl.RGBA_INTEGER, gl.UNSIGNED_INT, 16},
+		{gl.RED_INTEGER, gl.INT, 4},
+		{gl.DEPTH_COMPONENT, gl.FLOAT, 4},
+		{gl.DEPTH_COMPONENT, gl.UNSIGNED_SHORT, 2},
+		{gl.STENCIL_INDEX, gl.UNSIGNED_BYTE, 1},
+		{gl.RGB, gl.UNSIGNED_BYTE_3_3_2, 1},
+		{gl.RGB, gl.UNSIGNED_SHORT_5_6_5, 2},
+		{gl.RGBA, gl.UNSIGNED_SHORT_4_4_4_4_REV, 2},
+		{gl.BGRA, gl.UNSIGNED_SHORT_1_5_5_5_REV, 2},
+		{gl.RGBA, gl.UNSIGNED_INT_8_8_8_8_REV, 4},
+		{gl.RGBA, gl.UNSIGNED_INT_2_10_10_10_REV, 4},
+		{gl.RGB, gl.UNSIGNED_INT_10F_11F_11F_REV, 4},
+		{gl.RGB, gl.UNSIGNED_INT_5_9_9_9_REV, 4},
+		{gl.DEPTH_STENCIL, gl.UNSIGNED_INT_24_8, 4},
+		{gl.DEPTH_STENCIL, gl.FLOAT_32_UNSIGNED_INT_24_8_REV, 8},
+	}
+	for _, tt := range tests {
+		got, err := PixelSize(tt.format, tt.xtype)
+		if err != nil || got != tt.want {
+			t.Errorf("PixelSize(0x%X, 0x%X) = %d, %v; want %d", tt.format, tt.xtype, got, err, tt.want)
+		}
+	}
+
+	for _, tt := range []struct{ format, xtype uint32 }{
+		{gl.RGBA, gl.DOUBLE},
+		{gl.RGBA, 0},
+		{gl.LUMINANCE, gl.UNSIGNED_BYTE},
+		{0, gl.FLOAT},
+	} {
+		if got, err := PixelSize(tt.format, tt.xtype); err == nil {
+			t.Errorf("PixelSize(0x%X, 0x%X) = %d, want error", tt.format, tt.xtype, got)
+		}
+	}
+}
+
+func TestGetTexImageRobust(t *testing.T) {
+	defer testContext(t)()
+	pixels := []byte{
+		1, 2, 3, 4, 5, 6, 7, 8, 9,
+		10, 11, 12, 13, 14, 15, 16, 17, 18,
+	}
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	defer gl.DeleteTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	saved := SavePixelStore()
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 1)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGB8, 3, 2, 0, gl.RGB, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+	saved.Restore()
+
+	// A PACK_ALIGNMENT of 4 would pad the 9 byte rows; the result must be
+	// tightly packed regardless.
+	got, err := GetTexImageRobust(gl.TEXTURE_2D, 0, gl.RGB, gl.UNSIGNED_BYTE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, pixels) {
+		t.Errorf("GetTexImageRobust = %v, want %v", got, pixels)
+	}
+	if align := getInteger(gl.PACK_ALIGNMENT); align != 4 {
+		t.Errorf("PACK_ALIGNMENT = %d after GetTexImageRobust, want 4", align)
+	}
+	if _, err := GetTexImageRobust(gl.TEXTURE_2D, 1, gl.RGB, gl.UNSIGNED_BYTE); err == nil {
+		t.Error("GetTexImageRobust of an empty level succeeded")
+	}
+	if _, err := GetTexImageRobust(gl.TEXTURE_2D, 0, gl.RGB, gl.DOUBLE); err == nil {
+		t.Error("GetTexImageRobust with an unsupported type succeeded")
+	}
+	checkErrors(t)
+}
+
+func TestPreferredReadFormat(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFn := newTestFramebuffer(t, 1)
+	defer deleteFn()
+	format, xtype := PreferredReadFormat()
+	size, err := PixelSize(format, xtype)
+	if err != nil {
+		t.Fatalf("PreferredReadFormat() = 0x%X, 0x%X: %v", format, xtype, err)
+	}
+	// The preferred combination must be accepted by glReadPixels.
+	pixels := make([]byte, 4*4*size)
+	gl.ReadPixels(0, 0, 4, 4, format, xtype, gl.Ptr(pixels))
+	checkErrors(t)
+}
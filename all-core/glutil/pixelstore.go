@@ -0,0 +1,66 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// PixelStoreState holds the commonly used pixel pack and unpack parameters
+// set with glPixelStorei.
+type PixelStoreState struct {
+	PackAlignment   int32
+	PackRowLength   int32
+	PackImageHeight int32
+	PackSkipPixels  int32
+	PackSkipRows    int32
+	PackSkipImages  int32
+
+	UnpackAlignment   int32
+	UnpackRowLength   int32
+	UnpackImageHeight int32
+	UnpackSkipPixels  int32
+	UnpackSkipRows    int32
+	UnpackSkipImages  int32
+}
+
+// pixelStoreParam pairs a pixel store parameter name with its field in a
+// PixelStoreState.
+type pixelStoreParam struct {
+	pname uint32
+	value *int32
+}
+
+func (s *PixelStoreState) params() []pixelStoreParam {
+	return []pixelStoreParam{
+		{gl.PACK_ALIGNMENT, &s.PackAlignment},
+		{gl.PACK_ROW_LENGTH, &s.PackRowLength},
+		{gl.PACK_IMAGE_HEIGHT, &s.PackImageHeight},
+		{gl.PACK_SKIP_PIXELS, &s.PackSkipPixels},
+		{gl.PACK_SKIP_ROWS, &s.PackSkipRows},
+		{gl.PACK_SKIP_IMAGES, &s.PackSkipImages},
+		{gl.UNPACK_ALIGNMENT, &s.UnpackAlignment},
+		{gl.UNPACK_ROW_LENGTH, &s.UnpackRowLength},
+		{gl.UNPACK_IMAGE_HEIGHT, &s.UnpackImageHeight},
+		{gl.UNPACK_SKIP_PIXELS, &s.UnpackSkipPixels},
+		{gl.UNPACK_SKIP_ROWS, &s.UnpackSkipRows},
+		{gl.UNPACK_SKIP_IMAGES, &s.UnpackSkipImages},
+	}
+}
+
+// SavePixelStore returns the current pixel pack and unpack parameters, so they
+// can be restored after changing them:
+//
+//	saved := glutil.SavePixelStore()
+//	defer saved.Restore()
+//	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, stride)
+func SavePixelStore() PixelStoreState {
+	var s PixelStoreState
+	for _, p := range s.params() {
+		gl.GetIntegerv(p.pname, p.value)
+	}
+	return s
+}
+
+// Restore sets all pixel pack and unpack parameters to the values in s.
+func (s PixelStoreState) Restore() {
+	for _, p := range s.params() {
+		gl.PixelStorei(p.pname, *p.value)
+	}
+}
@@ -0,0 +1,36 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSavePixelStore(t *testing.T) {
+	defer testContext(t)()
+	saved := SavePixelStore()
+	if saved.PackAlignment != 4 || saved.UnpackAlignment != 4 || saved.UnpackRowLength != 0 {
+		t.Errorf("SavePixelStore() = %+v, want the default parameters", saved)
+	}
+
+	gl.PixelStorei(gl.UNPACK_ROW_LENGTH, 17)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 1)
+	gl.PixelStorei(gl.PACK_SKIP_ROWS, 3)
+	if changed := SavePixelStore(); changed.UnpackRowLength != 17 || changed.UnpackAlignment != 1 || changed.PackSkipRows != 3 {
+		t.Errorf("SavePixelStore() after changes = %+v", changed)
+	}
+	saved.Restore()
+	for _, p := range []struct {
+		pname uint32
+		want  int32
+	}{
+		{gl.UNPACK_ROW_LENGTH, 0},
+		{gl.UNPACK_ALIGNMENT, 4},
+		{gl.PACK_SKIP_ROWS, 0},
+	} {
+		if got := getInteger(p.pname); got != p.want {
+			t.Errorf("pixel store parameter 0x%X = %d after Restore, want %d", p.pname, got, p.want)
+		}
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,199 @@
+package glutil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// ProgramInfoLog returns the information log of a program object, which holds
+// the messages of the last link or validation.
+func ProgramInfoLog(program uint32) string {
+	var length int32
+	gl.GetProgramiv(program, gl.INFO_LOG_LENGTH, &length)
+	if length == 0 {
+		return ""
+	}
+	log := make([]uint8, length)
+	gl.GetProgramInfoLog(program, length, nil, &log[0])
+	return strings.TrimRight(string(log), "\x00")
+}
+
+// shaderStageNames maps shader types to the stage names used in errors.
+var shaderStageNames = map[uint32]string{
+	gl.VERTEX_SHADER:          "vertex",
+	gl.TESS_CONTROL_SHADER:    "tessellation control",
+	gl.TESS_EVALUATION_SHADER: "tessellation evaluation",
+	gl.GEOMETRY_SHADER:        "geometry",
+	gl.FRAGMENT_SHADER:        "fragment",
+	gl.COMPUTE_SHADER:         "compute",
+}
+
+// shaderSource is the source of one stage of a program.
+type shaderSource struct {
+	xtype  uint32
+	source string
+}
+
+// linkProgram compiles the shaders of the given stages and links them into a
+// new program. Errors are prefixed with the failing stage.
+func linkProgram(stages ...shaderSource) (uint32, error) {
+	program := gl.CreateProgram()
+	for _, s := range stages {
+		shader, err := CompileShader(s.xtype, s.source)
+		if err != nil {
+			gl.DeleteProgram(program)
+			return 0, fmt.Errorf("%s shader: %v", shaderStageNames[s.xtype], err)
+		}
+		gl.AttachShader(program, shader)
+		// The shader stays attached, and so alive, until the program is
+		// deleted.
+		gl.DeleteShader(shader)
+	}
+	gl.LinkProgram(program)
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+	if status == gl.FALSE {
+		log := ProgramInfoLog(program)
+		gl.DeleteProgram(program)
+		if log == "" {
+			log = "program link failed"
+		}
+		return 0, fmt.Errorf("link: %s", log)
+	}
+	return program, nil
+}
+
+// NewProgram compiles a vertex and a fragment shader and links them into a
+// program. If a stage fails to compile or the program fails to link, the
+// returned error names the stage, or "link", followed by the information log.
+func NewProgram(vertexSrc, fragmentSrc string) (uint32, error) {
+	return linkProgram(
+		shaderSource{gl.VERTEX_SHADER, vertexSrc},
+		shaderSource{gl.FRAGMENT_SHADER, fragmentSrc},
+	)
+}
+
+// NewProgramWithGeometry is like NewProgram, but also includes a geometry
+// shader between the vertex and fragment stages.
+func NewProgramWithGeometry(vertexSrc, geometrySrc, fragmentSrc string) (uint32, error) {
+	return linkProgram(
+		shaderSource{gl.VERTEX_SHADER, vertexSrc},
+		shaderSource{gl.GEOMETRY_SHADER, geometrySrc},
+		shaderSource{gl.FRAGMENT_SHADER, fragmentSrc},
+	)
+}
+
+// ValidateProgram checks whether program can execute given the current GL
+// state, for example that no two samplers of different types use the same
+// texture unit. If validation fails the returned error holds the program's
+// information log.
+func ValidateProgram(program uint32) error {
+	gl.ValidateProgram(program)
+	var status int32
+	gl.GetProgramiv(program, gl.VALIDATE_STATUS, &status)
+	if status == gl.FALSE {
+		log := ProgramInfoLog(program)
+		if log == "" {
+			log = "program validation failed"
+		}
+		return errors.New(log)
+	}
+	return nil
+}
+
+// ProgramResource describes an active resource of a program, such as a
+// fragment shader output.
+type ProgramResource struct {
+	Name      string
+	Index     uint32
+	Type      uint32 // GLSL type, e.g. gl.FLOAT_VEC4.
+	ArraySize int32
+	Location  int32
+}
+
+// programResources returns the active resources of program in the given
+// program interface, such as gl.PROGRAM_OUTPUT. It requires OpenGL 4.3.
+func programResources(program uint32, programInterface uint32) []ProgramResource {
+	var count int32
+	gl.GetProgramInterfaceiv(program, programInterface, gl.ACTIVE_RESOURCES, &count)
+	props := []uint32{gl.NAME_LENGTH, gl.TYPE, gl.ARRAY_SIZE, gl.LOCATION}
+	resources := make([]ProgramResource, count)
+	for i := range resources {
+		values := make([]int32, len(props))
+		gl.GetProgramResourceiv(program, programInterface, uint32(i), int32(len(props)), &props[0], int32(len(values)), nil, &values[0])
+		name := make([]uint8, values[0]+1)
+		gl.GetProgramResourceName(program, programInterface, uint32(i), int32(len(name)), nil, &name[0])
+		resources[i] = ProgramResource{
+			Name:      gl.GoStr(&name[0]),
+			Index:     uint32(i),
+			Type:      uint32(values[1]),
+			ArraySize: values[2],
+			Location:  values[3],
+		}
+	}
+	return resources
+}
+
+// FragmentOutputs returns the active output variables of the fragment stage of
+// a linked program. It requires OpenGL 4.3 and returns nil on older contexts.
+func FragmentOutputs(program uint32) []ProgramResource {
+	if !versionAtLeast(4, 3) {
+		return nil
+	}
+	return programResources(program, gl.PROGRAM_OUTPUT)
+}
+
+// BindFragDataLocations assigns sequential color numbers, starting at zero, to
+// the named fragment shader outputs, so that names[i] is written to draw buffer
+// i. The assignment only takes effect the next time program is linked.
+func BindFragDataLocations(program uint32, names ...string) {
+	for i, name := range names {
+		gl.BindFragDataLocation(program, uint32(i), gl.Str(name+"\x00"))
+	}
+}
+
+// BindAttribLocations assigns the given locations to the named vertex shader
+// inputs, so that programs sharing these assignments can be used with the
+// same vertex array layout. The assignment only takes effect the next time
+// program is linked, and explicit layout(location) qualifiers in the shader
+// take precedence.
+func BindAttribLocations(program uint32, locations map[string]uint32) {
+	for name, location := range locations {
+		gl.BindAttribLocation(program, location, gl.Str(name+"\x00"))
+	}
+}
+
+// shaderStageBits maps shader types to their bit in a shader stage bitmask.
+var shaderStageBits = map[uint32]uint32{
+	gl.VERTEX_SHADER:          gl.VERTEX_SHADER_BIT,
+	gl.TESS_CONTROL_SHADER:    gl.TESS_CONTROL_SHADER_BIT,
+	gl.TESS_EVALUATION_SHADER: gl.TESS_EVALUATION_SHADER_BIT,
+	gl.GEOMETRY_SHADER:        gl.GEOMETRY_SHADER_BIT,
+	gl.FRAGMENT_SHADER:        gl.FRAGMENT_SHADER_BIT,
+	gl.COMPUTE_SHADER:         gl.COMPUTE_SHADER_BIT,
+}
+
+// ProgramStages returns the shader stages of program as a bitmask such as
+// gl.VERTEX_SHADER_BIT|gl.FRAGMENT_SHADER_BIT, suitable for UseProgramStages.
+// The stages are those of the shaders currently attached to program, so
+// shaders detached after linking, including those of programs created by
+// NewSeparableProgram, are not reported.
+func ProgramStages(program uint32) uint32 {
+	var count int32
+	gl.GetProgramiv(program, gl.ATTACHED_SHADERS, &count)
+	if count == 0 {
+		return 0
+	}
+	shaders := make([]uint32, count)
+	gl.GetAttachedShaders(program, count, nil, &shaders[0])
+	var stages uint32
+	for _, shader := range shaders {
+		var xtype int32
+		gl.GetShaderiv(shader, gl.SHADER_TYPE, &xtype)
+		stages |= shaderStageBits[uint32(xtype)]
+	}
+	return stages
+}
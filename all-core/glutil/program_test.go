@@ -0,0 +1,176 @@
+package glutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+const twoSamplerShader = `#version 330 core
+uniform sampler2D plane;
+uniform samplerCube cube;
+out vec4 fragColor;
+void main() {
+	fragColor = texture(plane, vec2(0.5)) + texture(cube, vec3(1.0));
+}
+`
+
+func TestValidateProgram(t *testing.T) {
+	defer testContext(t)()
+	vao := bindEmptyVAO()
+	defer vao()
+	program := newTestProgram(t)
+	defer gl.DeleteProgram(program)
+	if err := ValidateProgram(program); err != nil {
+		t.Errorf("ValidateProgram of a valid program: %v", err)
+	}
+
+	// Samplers of different types must not use the same texture unit, which
+	// they both do by default.
+	broken, err := NewProgram(fullscreenVertexShader, twoSamplerShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(broken)
+	if err := ValidateProgram(broken); err == nil {
+		t.Error("ValidateProgram accepted a 2D and a cube sampler on the same unit")
+	} else if err.Error() == "" {
+		t.Error("ValidateProgram returned an empty error")
+	}
+	checkErrors(t)
+}
+
+const twoOutputShader = `#version 330 core
+out vec4 albedo;
+out vec4 normal;
+void main() {
+	albedo = vec4(1.0);
+	normal = vec4(0.0, 0.0, 1.0, 0.0);
+}
+`
+
+// linkTestProgram compiles the given vertex and fragment shaders, calls
+// beforeLink on the unlinked program, and links it.
+func linkTestProgram(t *testing.T, vertexSrc, fragmentSrc string, beforeLink func(program uint32)) uint32 {
+	t.Helper()
+	program := gl.CreateProgram()
+	for _, s := range []shaderSource{{gl.VERTEX_SHADER, vertexSrc}, {gl.FRAGMENT_SHADER, fragmentSrc}} {
+		shader, err := CompileShader(s.xtype, s.source)
+		if err != nil {
+			gl.DeleteProgram(program)
+			t.Fatal(err)
+		}
+		gl.AttachShader(program, shader)
+		gl.DeleteShader(shader)
+	}
+	beforeLink(program)
+	gl.LinkProgram(program)
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+	if status == gl.FALSE {
+		log := ProgramInfoLog(program)
+		gl.DeleteProgram(program)
+		t.Fatalf("link: %s", log)
+	}
+	return program
+}
+
+func TestBindFragDataLocations(t *testing.T) {
+	defer testContext(t)()
+	program := linkTestProgram(t, fullscreenVertexShader, twoOutputShader, func(program uint32) {
+		BindFragDataLocations(program, "normal", "albedo")
+	})
+	defer gl.DeleteProgram(program)
+
+	for name, want := range map[string]int32{"normal": 0, "albedo": 1} {
+		if got := gl.GetFragDataLocation(program, gl.Str(name+"\x00")); got != want {
+			t.Errorf("output %s has location %d, want %d", name, got, want)
+		}
+	}
+	if versionAtLeast(4, 3) {
+		outputs := FragmentOutputs(program)
+		locations := make(map[string]int32)
+		for _, o := range outputs {
+			locations[o.Name] = o.Location
+			if o.Type != gl.FLOAT_VEC4 {
+				t.Errorf("output %s has type 0x%X, want FLOAT_VEC4", o.Name, o.Type)
+			}
+		}
+		if len(outputs) != 2 || locations["normal"] != 0 || locations["albedo"] != 1 {
+			t.Errorf("FragmentOutputs() = %+v, want normal at 0 and albedo at 1", outputs)
+		}
+	}
+	checkErrors(t)
+}
+
+func TestProgramStages(t *testing.T) {
+	defer testContext(t)()
+	program := newTestProgram(t)
+	defer gl.DeleteProgram(program)
+	if got, want := ProgramStages(program), uint32(gl.VERTEX_SHADER_BIT|gl.FRAGMENT_SHADER_BIT); got != want {
+		t.Errorf("ProgramStages() = 0x%X, want 0x%X", got, want)
+	}
+	empty := gl.CreateProgram()
+	defer gl.DeleteProgram(empty)
+	if got := ProgramStages(empty); got != 0 {
+		t.Errorf("ProgramStages() of a program without shaders = 0x%X, want 0", got)
+	}
+	checkErrors(t)
+}
+
+const passThroughGeometryShader = `#version 330 core
+layout(triangles) in;
+layout(triangle_strip, max_vertices = 3) out;
+void main() {
+	for (int i = 0; i < 3; i++) {
+		gl_Position = gl_in[i].gl_Position;
+		EmitVertex();
+	}
+	EndPrimitive();
+}
+`
+
+func TestNewProgramWithGeometry(t *testing.T) {
+	defer testContext(t)()
+	program, err := NewProgramWithGeometry(fullscreenVertexShader, passThroughGeometryShader, emptyFragmentShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	want := uint32(gl.VERTEX_SHADER_BIT | gl.GEOMETRY_SHADER_BIT | gl.FRAGMENT_SHADER_BIT)
+	if got := ProgramStages(program); got != want {
+		t.Errorf("ProgramStages() = 0x%X, want 0x%X", got, want)
+	}
+
+	_, err = NewProgramWithGeometry(fullscreenVertexShader, "#version 330 core\nvoid main() { undefined(); }\n", emptyFragmentShader)
+	if err == nil {
+		t.Error("NewProgramWithGeometry succeeded with an invalid geometry shader")
+	} else if !strings.HasPrefix(err.Error(), "geometry shader: ") {
+		t.Errorf("error %q does not name the geometry stage", err)
+	}
+	checkErrors(t)
+}
+
+const twoAttributeShader = `#version 330 core
+in vec4 position;
+in vec4 offset;
+void main() {
+	gl_Position = position + offset;
+}
+`
+
+func TestBindAttribLocations(t *testing.T) {
+	defer testContext(t)()
+	locations := map[string]uint32{"position": 3, "offset": 5}
+	program := linkTestProgram(t, twoAttributeShader, emptyFragmentShader, func(program uint32) {
+		BindAttribLocations(program, locations)
+	})
+	defer gl.DeleteProgram(program)
+	for name, want := range locations {
+		if got := gl.GetAttribLocation(program, gl.Str(name+"\x00")); got != int32(want) {
+			t.Errorf("attribute %s has location %d, want %d", name, got, want)
+		}
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,95 @@
+package glutil
+
+import (
+	"math"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// QualityPreset combines the filtering, wrapping, mipmapping, and anisotropy
+// settings applied by ApplyTextureQuality. The predefined presets can be
+// copied and adjusted, for example to clamp instead of repeat:
+//
+//	q := glutil.QualityHigh
+//	q.Wrap = gl.CLAMP_TO_EDGE
+//	glutil.ApplyTextureQuality(gl.TEXTURE_2D, q)
+//
+// Zero fields leave the corresponding texture parameters unchanged.
+type QualityPreset struct {
+	// MinFilter and MagFilter are the minification and magnification
+	// filters. Mipmaps are generated if MinFilter uses them.
+	MinFilter, MagFilter int32
+	// Wrap is the wrap mode of all texture coordinates, e.g. gl.REPEAT.
+	Wrap int32
+	// Anisotropy is the degree of anisotropic filtering. It is clamped to
+	// MaxAnisotropy and ignored if anisotropic filtering is not supported.
+	Anisotropy float32
+}
+
+// Texture quality presets, from cheapest to best looking. All of them repeat
+// the texture.
+var (
+	// QualityLow uses bilinear filtering without mipmaps.
+	QualityLow = QualityPreset{MinFilter: gl.LINEAR, MagFilter: gl.LINEAR, Wrap: gl.REPEAT, Anisotropy: 1}
+	// QualityMedium uses bilinear filtering with the nearest mipmap.
+	QualityMedium = QualityPreset{MinFilter: gl.LINEAR_MIPMAP_NEAREST, MagFilter: gl.LINEAR, Wrap: gl.REPEAT, Anisotropy: 1}
+	// QualityHigh uses trilinear filtering and 4x anisotropic filtering.
+	QualityHigh = QualityPreset{MinFilter: gl.LINEAR_MIPMAP_LINEAR, MagFilter: gl.LINEAR, Wrap: gl.REPEAT, Anisotropy: 4}
+	// QualityUltra uses trilinear filtering and the maximum anisotropy the
+	// device supports.
+	QualityUltra = QualityPreset{MinFilter: gl.LINEAR_MIPMAP_LINEAR, MagFilter: gl.LINEAR, Wrap: gl.REPEAT, Anisotropy: math.MaxFloat32}
+)
+
+// anisotropySupported reports whether anisotropic filtering is available,
+// which is core in OpenGL 4.6.
+func anisotropySupported() bool {
+	return versionAtLeast(4, 6) ||
+		extensionSupported("GL_ARB_texture_filter_anisotropic") ||
+		extensionSupported("GL_EXT_texture_filter_anisotropic")
+}
+
+// MaxAnisotropy returns the maximum supported degree of anisotropic filtering,
+// or 1 if anisotropic filtering is not supported.
+func MaxAnisotropy() float32 {
+	if !anisotropySupported() {
+		return 1
+	}
+	var max float32
+	gl.GetFloatv(gl.MAX_TEXTURE_MAX_ANISOTROPY, &max)
+	return max
+}
+
+// ApplyTextureQuality sets the filtering, wrap modes, and anisotropy of the
+// texture bound to target according to a quality preset, generating mipmaps if
+// the preset uses them. The anisotropy is clamped to what the device supports.
+func ApplyTextureQuality(target uint32, q QualityPreset) {
+	switch q.MinFilter {
+	case gl.NEAREST_MIPMAP_NEAREST, gl.LINEAR_MIPMAP_NEAREST, gl.NEAREST_MIPMAP_LINEAR, gl.LINEAR_MIPMAP_LINEAR:
+		gl.GenerateMipmap(target)
+	}
+	params := []struct {
+		pname uint32
+		value int32
+	}{
+		{gl.TEXTURE_MIN_FILTER, q.MinFilter},
+		{gl.TEXTURE_MAG_FILTER, q.MagFilter},
+		{gl.TEXTURE_WRAP_S, q.Wrap},
+		{gl.TEXTURE_WRAP_T, q.Wrap},
+		{gl.TEXTURE_WRAP_R, q.Wrap},
+	}
+	for _, p := range params {
+		if p.value != 0 {
+			gl.TexParameteri(target, p.pname, p.value)
+		}
+	}
+	if q.Anisotropy != 0 && anisotropySupported() {
+		anisotropy := q.Anisotropy
+		if max := MaxAnisotropy(); anisotropy > max {
+			anisotropy = max
+		}
+		if anisotropy < 1 {
+			anisotropy = 1
+		}
+		gl.TexParameterf(target, gl.TEXTURE_MAX_ANISOTROPY, anisotropy)
+	}
+}
@@ -0,0 +1,69 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestApplyTextureQuality(t *testing.T) {
+	defer testContext(t)()
+	_, deleteTex := newTestTexture(t, 16, 16)
+	defer deleteTex()
+
+	ApplyTextureQuality(gl.TEXTURE_2D, QualityHigh)
+	for _, p := range []struct {
+		name  string
+		pname uint32
+		want  int32
+	}{
+		{"TEXTURE_MIN_FILTER", gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR},
+		{"TEXTURE_MAG_FILTER", gl.TEXTURE_MAG_FILTER, gl.LINEAR},
+		{"TEXTURE_WRAP_S", gl.TEXTURE_WRAP_S, gl.REPEAT},
+		{"TEXTURE_WRAP_T", gl.TEXTURE_WRAP_T, gl.REPEAT},
+		{"TEXTURE_WRAP_R", gl.TEXTURE_WRAP_R, gl.REPEAT},
+	} {
+		if got := texParameter(gl.TEXTURE_2D, p.pname); got != p.want {
+			t.Errorf("%s = 0x%X, want 0x%X", p.name, got, p.want)
+		}
+	}
+	var width int32
+	gl.GetTexLevelParameteriv(gl.TEXTURE_2D, 4, gl.TEXTURE_WIDTH, &width)
+	if width != 1 {
+		t.Errorf("width of mipmap level 4 = %d, want 1", width)
+	}
+	if anisotropySupported() {
+		var anisotropy float32
+		gl.GetTexParameterfv(gl.TEXTURE_2D, gl.TEXTURE_MAX_ANISOTROPY, &anisotropy)
+		want := float32(4)
+		if max := MaxAnisotropy(); max < want {
+			want = max
+		}
+		if anisotropy != want {
+			t.Errorf("TEXTURE_MAX_ANISOTROPY = %v, want %v", anisotropy, want)
+		}
+	}
+
+	q := QualityUltra
+	q.Wrap = gl.CLAMP_TO_EDGE
+	ApplyTextureQuality(gl.TEXTURE_2D, q)
+	if got := texParameter(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T); got != gl.CLAMP_TO_EDGE {
+		t.Errorf("TEXTURE_WRAP_T = 0x%X with a clamping preset, want CLAMP_TO_EDGE", got)
+	}
+	if anisotropySupported() {
+		var anisotropy float32
+		gl.GetTexParameterfv(gl.TEXTURE_2D, gl.TEXTURE_MAX_ANISOTROPY, &anisotropy)
+		if max := MaxAnisotropy(); anisotropy != max {
+			t.Errorf("TEXTURE_MAX_ANISOTROPY = %v with QualityUltra, want %v", anisotropy, max)
+		}
+	}
+
+	ApplyTextureQuality(gl.TEXTURE_2D, QualityPreset{MagFilter: gl.NEAREST})
+	if got := texParameter(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S); got != gl.CLAMP_TO_EDGE {
+		t.Errorf("TEXTURE_WRAP_S = 0x%X, want it unchanged by a zero Wrap", got)
+	}
+	if got := texParameter(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER); got != gl.LINEAR_MIPMAP_LINEAR {
+		t.Errorf("TEXTURE_MIN_FILTER = 0x%X, want it unchanged by a zero MinFilter", got)
+	}
+	checkErrors(t)
+}
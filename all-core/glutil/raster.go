@@ -0,0 +1,82 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// SupportsConservativeRaster reports whether the current context supports
+// GL_NV_conservative_raster.
+func SupportsConservativeRaster() bool {
+	return extensionSupported("GL_NV_conservative_raster")
+}
+
+// SetConservativeRaster enables or disables conservative rasterization, which
+// produces a fragment for every pixel a primitive touches, however little. It
+// is a no-op if conservative rasterization is not supported.
+func SetConservativeRaster(enabled bool) {
+	if !SupportsConservativeRaster() {
+		return
+	}
+	setEnabled(gl.CONSERVATIVE_RASTERIZATION_NV, enabled)
+}
+
+// SetLineWidthClamped sets the line width after clamping it to the range the
+// implementation supports, see LineWidthRange and SmoothLineWidthRange, so
+// that glLineWidth does not fail with gl.INVALID_VALUE. Forward-compatible
+// contexts reject any width above 1, so the width is clamped to 1 there.
+func SetLineWidthClamped(width float32) {
+	min, max := LineWidthRange()
+	if gl.IsEnabled(gl.LINE_SMOOTH) {
+		min, max = SmoothLineWidthRange()
+	}
+	if uint32(getInteger(gl.CONTEXT_FLAGS))&gl.CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT != 0 {
+		max = 1
+	}
+	if width > max {
+		width = max
+	}
+	if width < min {
+		width = min
+	}
+	gl.LineWidth(width)
+}
+
+// SetProvokingVertex selects which vertex of a primitive supplies the values of
+// flat-shaded outputs, gl.FIRST_VERTEX_CONVENTION or the default
+// gl.LAST_VERTEX_CONVENTION. Direct3D uses the first vertex, so data made for
+// it renders with wrong flat colors under the default.
+func SetProvokingVertex(mode uint32) {
+	gl.ProvokingVertex(mode)
+}
+
+// ProvokingVertex returns the current provoking vertex convention.
+func ProvokingVertex() uint32 {
+	return uint32(getInteger(gl.PROVOKING_VERTEX))
+}
+
+// SetCulling enables or disables face culling and sets which faces are culled,
+// usually gl.BACK, and which winding order makes a face front-facing, gl.CCW
+// by default. Meshes exported with clockwise winding disappear under the
+// defaults, which is a common cause of invisible geometry.
+func SetCulling(enabled bool, cullFace uint32, frontFace uint32) {
+	setEnabled(gl.CULL_FACE, enabled)
+	gl.CullFace(cullFace)
+	gl.FrontFace(frontFace)
+}
+
+// CullingState returns the face culling state set by SetCulling.
+func CullingState() (enabled bool, cullFace, frontFace uint32) {
+	return gl.IsEnabled(gl.CULL_FACE), uint32(getInteger(gl.CULL_FACE_MODE)), uint32(getInteger(gl.FRONT_FACE))
+}
+
+// SetPolygonOffset enables depth offset for filled polygons and sets its
+// slope-scaled factor and constant units, as used to draw decals over
+// coplanar geometry or to reduce shadow acne when rendering shadow maps.
+// Setting the values without enabling gl.POLYGON_OFFSET_FILL has no effect.
+func SetPolygonOffset(factor, units float32) {
+	gl.Enable(gl.POLYGON_OFFSET_FILL)
+	gl.PolygonOffset(factor, units)
+}
+
+// DisablePolygonOffset disables depth offset for filled polygons.
+func DisablePolygonOffset() {
+	gl.Disable(gl.POLYGON_OFFSET_FILL)
+}
@@ -0,0 +1,100 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSetConservativeRaster(t *testing.T) {
+	defer testContext(t)()
+	if !SupportsConservativeRaster() {
+		// Must be a no-op rather than an invalid enum error.
+		SetConservativeRaster(true)
+		checkErrors(t)
+		t.Skip("GL_NV_conservative_raster is not supported")
+	}
+	defer SetConservativeRaster(false)
+	for _, enabled := range []bool{true, false} {
+		SetConservativeRaster(enabled)
+		if got := gl.IsEnabled(gl.CONSERVATIVE_RASTERIZATION_NV); got != enabled {
+			t.Errorf("SetConservativeRaster(%v): IsEnabled(CONSERVATIVE_RASTERIZATION_NV) = %v", enabled, got)
+		}
+	}
+	checkErrors(t)
+}
+
+func TestSetLineWidthClamped(t *testing.T) {
+	defer testContext(t)()
+	defer gl.LineWidth(1)
+	min, max := LineWidthRange()
+	if uint32(getInteger(gl.CONTEXT_FLAGS))&gl.CONTEXT_FLAG_FORWARD_COMPATIBLE_BIT != 0 {
+		max = 1
+	}
+	for _, tt := range []struct{ width, want float32 }{
+		{1e6, max},
+		{0.001, min},
+	} {
+		SetLineWidthClamped(tt.width)
+		checkErrors(t)
+		var got float32
+		gl.GetFloatv(gl.LINE_WIDTH, &got)
+		if got != tt.want {
+			t.Errorf("SetLineWidthClamped(%v): LINE_WIDTH = %v, want %v", tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestSetProvokingVertex(t *testing.T) {
+	defer testContext(t)()
+	defer SetProvokingVertex(gl.LAST_VERTEX_CONVENTION)
+	SetProvokingVertex(gl.FIRST_VERTEX_CONVENTION)
+	if got := getInteger(gl.PROVOKING_VERTEX); got != gl.FIRST_VERTEX_CONVENTION {
+		t.Errorf("PROVOKING_VERTEX = 0x%X, want FIRST_VERTEX_CONVENTION", got)
+	}
+	if got := ProvokingVertex(); got != gl.FIRST_VERTEX_CONVENTION {
+		t.Errorf("ProvokingVertex() = 0x%X, want FIRST_VERTEX_CONVENTION", got)
+	}
+	checkErrors(t)
+}
+
+func TestSetCulling(t *testing.T) {
+	defer testContext(t)()
+	defer SetCulling(false, gl.BACK, gl.CCW)
+	SetCulling(true, gl.FRONT, gl.CW)
+	if enabled, cullFace, frontFace := CullingState(); !enabled || cullFace != gl.FRONT || frontFace != gl.CW {
+		t.Errorf("CullingState() = %v, 0x%X, 0x%X; want true, FRONT, CW", enabled, cullFace, frontFace)
+	}
+	SetCulling(true, gl.BACK, gl.CCW)
+	if !gl.IsEnabled(gl.CULL_FACE) {
+		t.Error("CULL_FACE is not enabled")
+	}
+	if got := getInteger(gl.CULL_FACE_MODE); got != gl.BACK {
+		t.Errorf("CULL_FACE_MODE = 0x%X, want BACK", got)
+	}
+	if got := getInteger(gl.FRONT_FACE); got != gl.CCW {
+		t.Errorf("FRONT_FACE = 0x%X, want CCW", got)
+	}
+	checkErrors(t)
+}
+
+func TestSetPolygonOffset(t *testing.T) {
+	defer testContext(t)()
+	defer gl.PolygonOffset(0, 0)
+	defer DisablePolygonOffset()
+	SetPolygonOffset(1.5, 4)
+	if !gl.IsEnabled(gl.POLYGON_OFFSET_FILL) {
+		t.Error("SetPolygonOffset did not enable POLYGON_OFFSET_FILL")
+	}
+	var factor, units float32
+	gl.GetFloatv(gl.POLYGON_OFFSET_FACTOR, &factor)
+	gl.GetFloatv(gl.POLYGON_OFFSET_UNITS, &units)
+	if factor != 1.5 || units != 4 {
+		t.Errorf("polygon offset = %v, %v; want 1.5, 4", factor, units)
+	}
+	DisablePolygonOffset()
+	if gl.IsEnabled(gl.POLYGON_OFFSET_FILL) {
+		t.Error("DisablePolygonOffset did not disable POLYGON_OFFSET_FILL")
+	}
+	checkErrors(t)
+}
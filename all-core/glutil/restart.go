@@ -0,0 +1,45 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// fixedIndexRestartSupported reports whether gl.PRIMITIVE_RESTART_FIXED_INDEX
+// is available.
+func fixedIndexRestartSupported() bool {
+	return versionAtLeast(4, 3) || extensionSupported("GL_ARB_ES3_compatibility")
+}
+
+// EnablePrimitiveRestart enables primitive restart with an explicit restart
+// index. The index is compared against the index values as-is, so it must be
+// representable in the index type used for drawing; for example 0xFFFF has no
+// effect with gl.UNSIGNED_INT indices. Fixed-index restart is disabled, since
+// it would take precedence.
+func EnablePrimitiveRestart(index uint32) {
+	if fixedIndexRestartSupported() {
+		gl.Disable(gl.PRIMITIVE_RESTART_FIXED_INDEX)
+	}
+	gl.Enable(gl.PRIMITIVE_RESTART)
+	gl.PrimitiveRestartIndex(index)
+}
+
+// EnablePrimitiveRestartFixed enables primitive restart using the maximum value
+// of the index type of each draw call (0xFF, 0xFFFF or 0xFFFFFFFF), as in
+// OpenGL ES. Explicit-index restart is disabled.
+//
+// EnablePrimitiveRestartFixed is a no-op before OpenGL 4.3 unless
+// GL_ARB_ES3_compatibility is supported.
+func EnablePrimitiveRestartFixed() {
+	if !fixedIndexRestartSupported() {
+		return
+	}
+	gl.Disable(gl.PRIMITIVE_RESTART)
+	gl.Enable(gl.PRIMITIVE_RESTART_FIXED_INDEX)
+}
+
+// DisablePrimitiveRestart disables both explicit-index and fixed-index
+// primitive restart.
+func DisablePrimitiveRestart() {
+	gl.Disable(gl.PRIMITIVE_RESTART)
+	if fixedIndexRestartSupported() {
+		gl.Disable(gl.PRIMITIVE_RESTART_FIXED_INDEX)
+	}
+}
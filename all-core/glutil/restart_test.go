@@ -0,0 +1,37 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestPrimitiveRestart(t *testing.T) {
+	defer testContext(t)()
+	defer DisablePrimitiveRestart()
+
+	EnablePrimitiveRestart(0xFFFF)
+	if !gl.IsEnabled(gl.PRIMITIVE_RESTART) {
+		t.Error("PRIMITIVE_RESTART is not enabled")
+	}
+	if index := getInteger(gl.PRIMITIVE_RESTART_INDEX); index != 0xFFFF {
+		t.Errorf("PRIMITIVE_RESTART_INDEX = 0x%X, want 0xFFFF", index)
+	}
+
+	if fixedIndexRestartSupported() {
+		EnablePrimitiveRestartFixed()
+		if gl.IsEnabled(gl.PRIMITIVE_RESTART) || !gl.IsEnabled(gl.PRIMITIVE_RESTART_FIXED_INDEX) {
+			t.Error("EnablePrimitiveRestartFixed did not switch to fixed-index restart")
+		}
+		EnablePrimitiveRestart(7)
+		if !gl.IsEnabled(gl.PRIMITIVE_RESTART) || gl.IsEnabled(gl.PRIMITIVE_RESTART_FIXED_INDEX) {
+			t.Error("EnablePrimitiveRestart did not switch to explicit-index restart")
+		}
+	}
+
+	DisablePrimitiveRestart()
+	if gl.IsEnabled(gl.PRIMITIVE_RESTART) || (fixedIndexRestartSupported() && gl.IsEnabled(gl.PRIMITIVE_RESTART_FIXED_INDEX)) {
+		t.Error("primitive restart is enabled after DisablePrimitiveRestart")
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,53 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// SamplerOptions configures a sampler object created by NewSampler. Zero
+// values leave the corresponding parameter at its OpenGL default.
+type SamplerOptions struct {
+	MinFilter uint32 // e.g. gl.LINEAR_MIPMAP_LINEAR.
+	MagFilter uint32 // e.g. gl.LINEAR.
+
+	WrapS, WrapT, WrapR uint32 // e.g. gl.CLAMP_TO_EDGE.
+
+	// Anisotropy is the maximum degree of anisotropic filtering. It is
+	// clamped to MaxAnisotropy and ignored if anisotropic filtering is not
+	// supported.
+	Anisotropy float32
+
+	// CompareMode and CompareFunc enable depth comparison, for example
+	// gl.COMPARE_REF_TO_TEXTURE with gl.LEQUAL for shadow maps.
+	CompareMode uint32
+	CompareFunc uint32
+}
+
+// NewSampler creates a sampler object configured by opts.
+func NewSampler(opts SamplerOptions) uint32 {
+	var sampler uint32
+	gl.GenSamplers(1, &sampler)
+	params := []struct {
+		pname uint32
+		value uint32
+	}{
+		{gl.TEXTURE_MIN_FILTER, opts.MinFilter},
+		{gl.TEXTURE_MAG_FILTER, opts.MagFilter},
+		{gl.TEXTURE_WRAP_S, opts.WrapS},
+		{gl.TEXTURE_WRAP_T, opts.WrapT},
+		{gl.TEXTURE_WRAP_R, opts.WrapR},
+		{gl.TEXTURE_COMPARE_MODE, opts.CompareMode},
+		{gl.TEXTURE_COMPARE_FUNC, opts.CompareFunc},
+	}
+	for _, p := range params {
+		if p.value != 0 {
+			gl.SamplerParameteri(sampler, p.pname, int32(p.value))
+		}
+	}
+	if opts.Anisotropy > 1 && anisotropySupported() {
+		anisotropy := opts.Anisotropy
+		if max := MaxAnisotropy(); anisotropy > max {
+			anisotropy = max
+		}
+		gl.SamplerParameterf(sampler, gl.TEXTURE_MAX_ANISOTROPY, anisotropy)
+	}
+	return sampler
+}
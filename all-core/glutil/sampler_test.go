@@ -0,0 +1,50 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestNewSampler(t *testing.T) {
+	defer testContext(t)()
+	sampler := NewSampler(SamplerOptions{
+		MinFilter:   gl.LINEAR_MIPMAP_LINEAR,
+		MagFilter:   gl.NEAREST,
+		WrapS:       gl.CLAMP_TO_EDGE,
+		WrapT:       gl.MIRRORED_REPEAT,
+		Anisotropy:  1e6,
+		CompareMode: gl.COMPARE_REF_TO_TEXTURE,
+		CompareFunc: gl.LEQUAL,
+	})
+	defer gl.DeleteSamplers(1, &sampler)
+
+	for _, p := range []struct {
+		name  string
+		pname uint32
+		want  int32
+	}{
+		{"TEXTURE_MIN_FILTER", gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR},
+		{"TEXTURE_MAG_FILTER", gl.TEXTURE_MAG_FILTER, gl.NEAREST},
+		{"TEXTURE_WRAP_S", gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE},
+		{"TEXTURE_WRAP_T", gl.TEXTURE_WRAP_T, gl.MIRRORED_REPEAT},
+		// Unset options keep their defaults.
+		{"TEXTURE_WRAP_R", gl.TEXTURE_WRAP_R, gl.REPEAT},
+		{"TEXTURE_COMPARE_MODE", gl.TEXTURE_COMPARE_MODE, gl.COMPARE_REF_TO_TEXTURE},
+		{"TEXTURE_COMPARE_FUNC", gl.TEXTURE_COMPARE_FUNC, gl.LEQUAL},
+	} {
+		var got int32
+		gl.GetSamplerParameteriv(sampler, p.pname, &got)
+		if got != p.want {
+			t.Errorf("%s = 0x%X, want 0x%X", p.name, got, p.want)
+		}
+	}
+	if anisotropySupported() {
+		var anisotropy float32
+		gl.GetSamplerParameterfv(sampler, gl.TEXTURE_MAX_ANISOTROPY, &anisotropy)
+		if anisotropy != MaxAnisotropy() {
+			t.Errorf("TEXTURE_MAX_ANISOTROPY = %v, want it clamped to %v", anisotropy, MaxAnisotropy())
+		}
+	}
+	checkErrors(t)
+}
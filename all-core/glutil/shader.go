@@ -0,0 +1,105 @@
+package glutil
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// ShaderInfoLog returns the information log of a shader object, which holds
+// the messages of its last compilation or specialization.
+func ShaderInfoLog(shader uint32) string {
+	var length int32
+	gl.GetShaderiv(shader, gl.INFO_LOG_LENGTH, &length)
+	if length == 0 {
+		return ""
+	}
+	log := make([]uint8, length)
+	gl.GetShaderInfoLog(shader, length, nil, &log[0])
+	return strings.TrimRight(string(log), "\x00")
+}
+
+// CompileShader creates a shader of the given type, such as gl.VERTEX_SHADER,
+// and compiles source into it. If compiling fails the shader is deleted and
+// the returned error holds its information log.
+func CompileShader(xtype uint32, source string) (uint32, error) {
+	shader := gl.CreateShader(xtype)
+	csource, free := gl.Strs(source + "\x00")
+	gl.ShaderSource(shader, 1, csource, nil)
+	free()
+	gl.CompileShader(shader)
+	var status int32
+	gl.GetShaderiv(shader, gl.COMPILE_STATUS, &status)
+	if status == gl.FALSE {
+		log := ShaderInfoLog(shader)
+		gl.DeleteShader(shader)
+		if log == "" {
+			log = "shader compilation failed"
+		}
+		return 0, errors.New(log)
+	}
+	return shader, nil
+}
+
+// ShaderPrecisionFormat returns the range and precision of a numeric format
+// for a shader stage, such as gl.FRAGMENT_SHADER with gl.MEDIUM_FLOAT.
+//
+// The range is given as the log2 of the minimum and maximum representable
+// magnitudes and precision as the log2 of the relative precision, or zero for
+// integer formats. Desktop implementations generally do not have reduced
+// precision types and report full IEEE single precision (127, 127, 23) for all
+// float formats and 32-bit integers (31, 30, 0) for all int formats.
+//
+// The query requires OpenGL 4.1 or GL_ARB_ES2_compatibility; all values are
+// zero when neither is available.
+func ShaderPrecisionFormat(shaderType, precisionType uint32) (rangeMin, rangeMax, precision int32) {
+	if !versionAtLeast(4, 1) && !extensionSupported("GL_ARB_ES2_compatibility") {
+		return 0, 0, 0
+	}
+	var xrange [2]int32
+	gl.GetShaderPrecisionFormat(shaderType, precisionType, &xrange[0], &precision)
+	return xrange[0], xrange[1], precision
+}
+
+// SupportsShaderFP64 reports whether shaders can use double precision types
+// such as double and dvec3, which is core in OpenGL 4.0 and otherwise provided
+// by GL_ARB_gpu_shader_fp64. There is no separate limit for double precision
+// values; each one counts as two components against the regular uniform and
+// varying limits.
+func SupportsShaderFP64() bool {
+	return versionAtLeast(4, 0) || extensionSupported("GL_ARB_gpu_shader_fp64")
+}
+
+// SupportsGeometryShader reports whether geometry shaders are available, which
+// requires OpenGL 3.2 and the functions specific to them, such as
+// glFramebufferTexture for layered rendering, to be loaded.
+func SupportsGeometryShader() bool {
+	return versionAtLeast(3, 2) && gl.FunctionLoaded("glFramebufferTexture")
+}
+
+// SupportsTransformFeedback reports whether vertex shader outputs can be
+// captured into buffers with transform feedback, which requires OpenGL 3.0
+// and the functions it relies on to be loaded. On OpenGL 4.0 and later
+// glDrawTransformFeedback, which replays captured vertices without reading
+// back their count, must be loaded as well.
+func SupportsTransformFeedback() bool {
+	if !versionAtLeast(3, 0) {
+		return false
+	}
+	names := []string{
+		"glTransformFeedbackVaryings",
+		"glBeginTransformFeedback",
+		"glEndTransformFeedback",
+		"glBindBufferBase",
+	}
+	if versionAtLeast(4, 0) {
+		names = append(names, "glDrawTransformFeedback")
+	}
+	for _, name := range names {
+		if !gl.FunctionLoaded(name) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestShaderPrecisionFormat(t *testing.T) {
+	defer testContext(t)()
+	requireContextVersion(t, 4, 1)
+	rangeMin, rangeMax, precision := ShaderPrecisionFormat(gl.FRAGMENT_SHADER, gl.MEDIUM_FLOAT)
+	checkErrors(t)
+	// The ES 2.0 minimum for mediump float is a range of 2^14 and a relative
+	// precision of 2^-10, which desktop implementations exceed.
+	if rangeMin < 14 || rangeMax < 14 || precision < 10 {
+		t.Errorf("ShaderPrecisionFormat(FRAGMENT_SHADER, MEDIUM_FLOAT) = %d, %d, %d; want at least 14, 14, 10", rangeMin, rangeMax, precision)
+	}
+}
+
+func TestSupportsShaderFP64(t *testing.T) {
+	defer testContext(t)()
+	got := SupportsShaderFP64()
+	if versionAtLeast(4, 0) && !got {
+		t.Error("SupportsShaderFP64() = false on an OpenGL 4.0 or later context")
+	}
+	if !got {
+		return
+	}
+	// A shader using doubles must compile if they are reported as supported.
+	shader, err := CompileShader(gl.VERTEX_SHADER, `#version 400 core
+uniform dvec3 offset;
+void main() {
+	gl_Position = vec4(vec3(offset), 1.0);
+}
+`)
+	if err != nil {
+		t.Fatalf("double precision shader: %v", err)
+	}
+	gl.DeleteShader(shader)
+	checkErrors(t)
+}
+
+func TestSupportsGeometryShader(t *testing.T) {
+	defer testContext(t)()
+	if got, want := SupportsGeometryShader(), versionAtLeast(3, 2); got != want {
+		t.Errorf("SupportsGeometryShader() = %v, want %v for this context version", got, want)
+	}
+	if got, want := SupportsTransformFeedback(), versionAtLeast(3, 0); got != want {
+		t.Errorf("SupportsTransformFeedback() = %v, want %v for this context version", got, want)
+	}
+	if versionAtLeast(4, 0) && !gl.FunctionLoaded("glDrawTransformFeedback") {
+		t.Error("glDrawTransformFeedback is not loaded on an OpenGL 4 context")
+	}
+	checkErrors(t)
+}
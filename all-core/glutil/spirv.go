@@ -0,0 +1,69 @@
+package glutil
+
+import (
+	"errors"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// SupportsSPIRV reports whether shaders can be loaded from SPIR-V binaries,
+// which requires OpenGL 4.6 or GL_ARB_gl_spirv.
+func SupportsSPIRV() bool {
+	return versionAtLeast(4, 6) || extensionSupported("GL_ARB_gl_spirv")
+}
+
+// SPIRVExtensions returns the SPIR-V extensions that SPIR-V modules may use in
+// addition to the core capabilities. The list is only available from OpenGL
+// 4.6 onwards and is nil on older contexts.
+func SPIRVExtensions() []string {
+	if !versionAtLeast(4, 6) {
+		return nil
+	}
+	n := getInteger(gl.NUM_SPIR_V_EXTENSIONS)
+	extensions := make([]string, 0, n)
+	for i := int32(0); i < n; i++ {
+		extensions = append(extensions, gl.GoStr(gl.GetStringi(gl.SPIR_V_EXTENSIONS, uint32(i))))
+	}
+	return extensions
+}
+
+// ShaderBinary loads a precompiled binary into each of shaders, for example a
+// SPIR-V module with gl.SHADER_BINARY_FORMAT_SPIR_V. SPIR-V shaders must then
+// be specialized with SpecializeShader before they can be attached and
+// linked. It is a no-op if shaders or binary are empty or the context does not
+// support shader binaries, which requires OpenGL 4.1 or
+// GL_ARB_ES2_compatibility.
+func ShaderBinary(shaders []uint32, format uint32, binary []byte) {
+	if len(shaders) == 0 || len(binary) == 0 {
+		return
+	}
+	if !versionAtLeast(4, 1) && !extensionSupported("GL_ARB_ES2_compatibility") {
+		return
+	}
+	gl.ShaderBinary(int32(len(shaders)), &shaders[0], format, gl.Ptr(binary), int32(len(binary)))
+}
+
+// SpecializeShader selects the entry point of a shader loaded from a SPIR-V
+// binary, such as "main", and compiles it, leaving specialization constants at
+// their defaults. If specialization fails the returned error holds the
+// shader's information log.
+func SpecializeShader(shader uint32, entryPoint string) error {
+	if !SupportsSPIRV() {
+		return requireVersion("glSpecializeShader", 4, 6)
+	}
+	if versionAtLeast(4, 6) {
+		gl.SpecializeShader(shader, gl.Str(entryPoint+"\x00"), 0, nil, nil)
+	} else {
+		gl.SpecializeShaderARB(shader, gl.Str(entryPoint+"\x00"), 0, nil, nil)
+	}
+	var status int32
+	gl.GetShaderiv(shader, gl.COMPILE_STATUS, &status)
+	if status == gl.FALSE {
+		log := ShaderInfoLog(shader)
+		if log == "" {
+			log = "shader specialization failed"
+		}
+		return errors.New(log)
+	}
+	return nil
+}
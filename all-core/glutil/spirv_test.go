@@ -0,0 +1,61 @@
+package glutil
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// emptyFragmentSPIRV is a SPIR-V module with a fragment shader entry point
+// "main" that does nothing.
+var emptyFragmentSPIRV = []uint32{
+	0x07230203, 0x00010000, 0, 5, 0, // header with an id bound of 5
+	0x00020011, 1, // OpCapability Shader
+	0x0003000E, 0, 1, // OpMemoryModel Logical GLSL450
+	0x0005000F, 4, 1, 0x6E69616D, 0, // OpEntryPoint Fragment %1 "main"
+	0x00030010, 1, 8, // OpExecutionMode %1 OriginLowerLeft
+	0x00020013, 2, // %2 = OpTypeVoid
+	0x00030021, 3, 2, // %3 = OpTypeFunction %2
+	0x00050036, 2, 1, 0, 3, // %1 = OpFunction %2 None %3
+	0x000200F8, 4, // %4 = OpLabel
+	0x000100FD, // OpReturn
+	0x00010038, // OpFunctionEnd
+}
+
+func TestSpecializeShader(t *testing.T) {
+	defer testContext(t)()
+	if !SupportsSPIRV() {
+		if err := SpecializeShader(0, "main"); err == nil {
+			t.Error("SpecializeShader succeeded without SPIR-V support")
+		}
+		t.Skip("requires OpenGL 4.6 or GL_ARB_gl_spirv")
+	}
+	module := make([]byte, 4*len(emptyFragmentSPIRV))
+	for i, word := range emptyFragmentSPIRV {
+		binary.LittleEndian.PutUint32(module[4*i:], word)
+	}
+
+	shader := gl.CreateShader(gl.FRAGMENT_SHADER)
+	defer gl.DeleteShader(shader)
+	ShaderBinary([]uint32{shader}, gl.SHADER_BINARY_FORMAT_SPIR_V, module)
+	var spirv int32
+	gl.GetShaderiv(shader, gl.SPIR_V_BINARY, &spirv)
+	if spirv != gl.TRUE {
+		t.Fatal("SPIR_V_BINARY is not set after ShaderBinary")
+	}
+	if err := SpecializeShader(shader, "main"); err != nil {
+		t.Errorf("SpecializeShader: %v", err)
+	}
+
+	missing := gl.CreateShader(gl.FRAGMENT_SHADER)
+	defer gl.DeleteShader(missing)
+	ShaderBinary([]uint32{missing}, gl.SHADER_BINARY_FORMAT_SPIR_V, module)
+	if err := SpecializeShader(missing, "other"); err == nil {
+		t.Error("SpecializeShader succeeded with a missing entry point")
+	}
+	if err := gl.GetError(); err != gl.INVALID_VALUE {
+		t.Errorf("GL error after specializing a missing entry point = 0x%X, want INVALID_VALUE", err)
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,89 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// WithProgram makes program current, calls fn, and then restores the program
+// that was current beforehand.
+func WithProgram(program uint32, fn func()) {
+	var prev int32
+	gl.GetIntegerv(gl.CURRENT_PROGRAM, &prev)
+	gl.UseProgram(program)
+	defer gl.UseProgram(uint32(prev))
+	fn()
+}
+
+// setEnabled enables or disables a server-side capability.
+func setEnabled(capability uint32, enabled bool) {
+	if enabled {
+		gl.Enable(capability)
+	} else {
+		gl.Disable(capability)
+	}
+}
+
+// WithPolygonMode sets the polygon rasterization mode, such as gl.LINE for
+// wireframe rendering, calls fn, and then restores the previous mode.
+//
+// The mode is applied to gl.FRONT_AND_BACK, the only face the core profile
+// accepts.
+func WithPolygonMode(mode uint32, fn func()) {
+	// Some implementations still report separate front and back modes, so make
+	// room for both.
+	var prev [2]int32
+	gl.GetIntegerv(gl.POLYGON_MODE, &prev[0])
+	gl.PolygonMode(gl.FRONT_AND_BACK, mode)
+	defer gl.PolygonMode(gl.FRONT_AND_BACK, uint32(prev[0]))
+	fn()
+}
+
+// PipelineState holds the program, vertex array and buffer bindings and active
+// texture unit, as saved by SavePipeline.
+type PipelineState struct {
+	Program            uint32
+	VertexArray        uint32
+	ArrayBuffer        uint32
+	ElementArrayBuffer uint32
+	ActiveTexture      uint32
+}
+
+// SavePipeline returns the current program, vertex array and buffer bindings
+// and active texture unit, so that code rendering with its own state can be
+// nested cleanly by calling Restore afterwards.
+func SavePipeline() PipelineState {
+	return PipelineState{
+		Program:            uint32(getInteger(gl.CURRENT_PROGRAM)),
+		VertexArray:        uint32(getInteger(gl.VERTEX_ARRAY_BINDING)),
+		ArrayBuffer:        uint32(getInteger(gl.ARRAY_BUFFER_BINDING)),
+		ElementArrayBuffer: uint32(getInteger(gl.ELEMENT_ARRAY_BUFFER_BINDING)),
+		ActiveTexture:      uint32(getInteger(gl.ACTIVE_TEXTURE)),
+	}
+}
+
+// Restore makes the saved state current again. The vertex array is bound
+// before the element array buffer, since the latter is part of the vertex
+// array's state.
+func (s PipelineState) Restore() {
+	gl.UseProgram(s.Program)
+	gl.BindVertexArray(s.VertexArray)
+	gl.BindBuffer(gl.ARRAY_BUFFER, s.ArrayBuffer)
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, s.ElementArrayBuffer)
+	gl.ActiveTexture(s.ActiveTexture)
+}
+
+// SetColorMask selects which color channels drawing and clearing write to.
+func SetColorMask(r, g, b, a bool) {
+	gl.ColorMask(r, g, b, a)
+}
+
+// SaveColorMask returns the current color write mask in red, green, blue,
+// alpha order, for restoring with RestoreColorMask after a masked pass.
+func SaveColorMask() [4]bool {
+	var mask [4]bool
+	gl.GetBooleanv(gl.COLOR_WRITEMASK, &mask[0])
+	return mask
+}
+
+// RestoreColorMask sets the color write mask returned by SaveColorMask.
+func RestoreColorMask(mask [4]bool) {
+	gl.ColorMask(mask[0], mask[1], mask[2], mask[3])
+}
@@ -0,0 +1,105 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestWithProgram(t *testing.T) {
+	defer testContext(t)()
+	outer := newTestProgram(t)
+	defer gl.DeleteProgram(outer)
+	inner := newTestProgram(t)
+	defer gl.DeleteProgram(inner)
+
+	gl.UseProgram(outer)
+	defer gl.UseProgram(0)
+	called := false
+	WithProgram(inner, func() {
+		called = true
+		if p := getInteger(gl.CURRENT_PROGRAM); uint32(p) != inner {
+			t.Errorf("current program in fn = %d, want %d", p, inner)
+		}
+	})
+	if !called {
+		t.Error("WithProgram did not call fn")
+	}
+	if p := getInteger(gl.CURRENT_PROGRAM); uint32(p) != outer {
+		t.Errorf("current program after WithProgram = %d, want %d", p, outer)
+	}
+	checkErrors(t)
+}
+
+func TestWithPolygonMode(t *testing.T) {
+	defer testContext(t)()
+	var mode [2]int32
+	WithPolygonMode(gl.LINE, func() {
+		gl.GetIntegerv(gl.POLYGON_MODE, &mode[0])
+		if mode[0] != gl.LINE {
+			t.Errorf("polygon mode in fn = 0x%X, want LINE", mode[0])
+		}
+	})
+	gl.GetIntegerv(gl.POLYGON_MODE, &mode[0])
+	if mode[0] != gl.FILL {
+		t.Errorf("polygon mode after WithPolygonMode = 0x%X, want FILL", mode[0])
+	}
+	checkErrors(t)
+}
+
+func TestSavePipeline(t *testing.T) {
+	defer testContext(t)()
+	program := newTestProgram(t)
+	defer gl.DeleteProgram(program)
+	var vaos [2]uint32
+	gl.GenVertexArrays(2, &vaos[0])
+	defer gl.DeleteVertexArrays(2, &vaos[0])
+	var bufs [4]uint32
+	gl.GenBuffers(4, &bufs[0])
+	defer gl.DeleteBuffers(4, &bufs[0])
+
+	want := PipelineState{
+		Program:            program,
+		VertexArray:        vaos[0],
+		ArrayBuffer:        bufs[0],
+		ElementArrayBuffer: bufs[1],
+		ActiveTexture:      gl.TEXTURE3,
+	}
+	want.Restore()
+	defer PipelineState{ActiveTexture: gl.TEXTURE0}.Restore()
+	saved := SavePipeline()
+	if saved != want {
+		t.Errorf("SavePipeline() = %+v, want %+v", saved, want)
+	}
+
+	gl.UseProgram(0)
+	gl.BindVertexArray(vaos[1])
+	gl.BindBuffer(gl.ARRAY_BUFFER, bufs[2])
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, bufs[3])
+	gl.ActiveTexture(gl.TEXTURE0)
+	saved.Restore()
+	if got := SavePipeline(); got != want {
+		t.Errorf("state after Restore = %+v, want %+v", got, want)
+	}
+	checkErrors(t)
+}
+
+func TestSaveColorMask(t *testing.T) {
+	defer testContext(t)()
+	saved := SaveColorMask()
+	if want := [4]bool{true, true, true, true}; saved != want {
+		t.Fatalf("SaveColorMask() = %v, want %v", saved, want)
+	}
+	SetColorMask(true, false, true, true)
+	var mask [4]bool
+	gl.GetBooleanv(gl.COLOR_WRITEMASK, &mask[0])
+	if want := [4]bool{true, false, true, true}; mask != want {
+		t.Errorf("COLOR_WRITEMASK after SetColorMask = %v, want %v", mask, want)
+	}
+	RestoreColorMask(saved)
+	gl.GetBooleanv(gl.COLOR_WRITEMASK, &mask[0])
+	if mask != saved {
+		t.Errorf("COLOR_WRITEMASK after RestoreColorMask = %v, want %v", mask, saved)
+	}
+	checkErrors(t)
+}
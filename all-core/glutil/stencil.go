@@ -0,0 +1,22 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// SetStencil enables the stencil test and configures it for both faces in one
+// call. The fn, ref, and mask arguments are those of glStencilFunc and sfail,
+// dpfail, and dppass those of glStencilOp. The mask is also used as the
+// stencil write mask, so that only the bits taking part in the test are
+// written.
+func SetStencil(fn uint32, ref int32, mask uint32, sfail, dpfail, dppass uint32) {
+	gl.Enable(gl.STENCIL_TEST)
+	gl.StencilFunc(fn, ref, mask)
+	gl.StencilOp(sfail, dpfail, dppass)
+	gl.StencilMask(mask)
+}
+
+// DisableStencil disables the stencil test and restores the default stencil
+// write mask, so clearing the stencil buffer affects all bits again.
+func DisableStencil() {
+	gl.Disable(gl.STENCIL_TEST)
+	gl.StencilMask(^uint32(0))
+}
@@ -0,0 +1,47 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSetStencil(t *testing.T) {
+	defer testContext(t)()
+	SetStencil(gl.EQUAL, 1, 0xFF, gl.KEEP, gl.INCR, gl.REPLACE)
+	if !gl.IsEnabled(gl.STENCIL_TEST) {
+		t.Error("stencil test is not enabled")
+	}
+	for _, s := range []struct {
+		name  string
+		pname uint32
+		want  int32
+	}{
+		{"STENCIL_FUNC", gl.STENCIL_FUNC, gl.EQUAL},
+		{"STENCIL_REF", gl.STENCIL_REF, 1},
+		{"STENCIL_VALUE_MASK", gl.STENCIL_VALUE_MASK, 0xFF},
+		{"STENCIL_WRITEMASK", gl.STENCIL_WRITEMASK, 0xFF},
+		{"STENCIL_FAIL", gl.STENCIL_FAIL, gl.KEEP},
+		{"STENCIL_PASS_DEPTH_FAIL", gl.STENCIL_PASS_DEPTH_FAIL, gl.INCR},
+		{"STENCIL_PASS_DEPTH_PASS", gl.STENCIL_PASS_DEPTH_PASS, gl.REPLACE},
+		{"STENCIL_BACK_FUNC", gl.STENCIL_BACK_FUNC, gl.EQUAL},
+		{"STENCIL_BACK_PASS_DEPTH_PASS", gl.STENCIL_BACK_PASS_DEPTH_PASS, gl.REPLACE},
+	} {
+		if got := getInteger(s.pname); got != s.want {
+			t.Errorf("%s = 0x%X, want 0x%X", s.name, got, s.want)
+		}
+	}
+
+	DisableStencil()
+	gl.StencilFunc(gl.ALWAYS, 0, ^uint32(0))
+	gl.StencilOp(gl.KEEP, gl.KEEP, gl.KEEP)
+	if gl.IsEnabled(gl.STENCIL_TEST) {
+		t.Error("stencil test is enabled after DisableStencil")
+	}
+	// The all-ones mask may be clamped to the largest int32 when queried, so
+	// only check the bits of the 8-bit stencil buffer.
+	if mask := getInteger(gl.STENCIL_WRITEMASK); mask&0xFF != 0xFF {
+		t.Errorf("STENCIL_WRITEMASK = 0x%X after DisableStencil, want all bits", mask)
+	}
+	checkErrors(t)
+}
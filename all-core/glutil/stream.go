@@ -0,0 +1,103 @@
+package glutil
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// StreamBuffer is a persistently mapped buffer divided into regions that are
+// written in turn, for streaming data such as per-frame uniforms or dynamic
+// vertices to the GPU without stalls or reallocations. A fence guards each
+// region, so that it is not overwritten while the GPU may still read it.
+//
+// Each frame, call Next to get the region to write, write the data, issue the
+// draw calls reading it at the returned offset of Buffer, and then call
+// Commit.
+type StreamBuffer struct {
+	buffer     uint32
+	data       unsafe.Pointer
+	regionSize int
+	fences     []Fence
+	current    int
+}
+
+// NewStreamBuffer creates a StreamBuffer of regions regions of regionSize bytes
+// each. Three regions are usually enough to keep the CPU and GPU from waiting
+// on each other. The region size must satisfy the offset alignment of the
+// binding the data is used with, such as gl.UNIFORM_BUFFER_OFFSET_ALIGNMENT.
+//
+// NewStreamBuffer returns nil if the sizes are not positive or buffer storage
+// is not supported, see NewStorageBuffer.
+func NewStreamBuffer(regionSize int, regions int) *StreamBuffer {
+	if regionSize <= 0 || regions <= 0 {
+		return nil
+	}
+	const flags = gl.MAP_WRITE_BIT | gl.MAP_PERSISTENT_BIT | gl.MAP_COHERENT_BIT
+	size := regionSize * regions
+	buf, err := NewStorageBuffer(gl.COPY_WRITE_BUFFER, make([]byte, size), flags)
+	if err != nil {
+		return nil
+	}
+
+	prev := boundBuffer(gl.COPY_WRITE_BUFFER)
+	defer gl.BindBuffer(gl.COPY_WRITE_BUFFER, prev)
+	gl.BindBuffer(gl.COPY_WRITE_BUFFER, buf)
+	data := gl.MapBufferRange(gl.COPY_WRITE_BUFFER, 0, size, flags)
+	if data == nil {
+		gl.DeleteBuffers(1, &buf)
+		return nil
+	}
+	return &StreamBuffer{
+		buffer:     buf,
+		data:       data,
+		regionSize: regionSize,
+		fences:     make([]Fence, regions),
+	}
+}
+
+// Buffer returns the name of the underlying buffer, to be bound for the draw
+// calls reading the streamed data.
+func (s *StreamBuffer) Buffer() uint32 {
+	return s.buffer
+}
+
+// Next waits until the GPU has finished reading the current region and returns
+// a pointer to it for writing, along with its offset in bytes in the buffer.
+// If waiting fails, for example because the context was lost, the error is
+// returned and the region must not be written.
+func (s *StreamBuffer) Next() (ptr unsafe.Pointer, offset int, err error) {
+	if f := s.fences[s.current]; f != 0 {
+		if err := f.Wait(time.Duration(math.MaxInt64)); err != nil {
+			return nil, 0, fmt.Errorf("waiting for stream buffer region %d: %v", s.current, err)
+		}
+		f.Delete()
+		s.fences[s.current] = 0
+	}
+	offset = s.current * s.regionSize
+	return unsafe.Pointer(uintptr(s.data) + uintptr(offset)), offset, nil
+}
+
+// Commit marks the current region as in use by the commands issued since Next
+// and moves on to the next region. It must be called after the draw calls
+// reading the region.
+func (s *StreamBuffer) Commit() {
+	s.fences[s.current] = NewFence()
+	s.current = (s.current + 1) % len(s.fences)
+}
+
+// Delete deletes the buffer, which also unmaps it, along with its pending
+// fences.
+func (s *StreamBuffer) Delete() {
+	for i, f := range s.fences {
+		if f != 0 {
+			f.Delete()
+			s.fences[i] = 0
+		}
+	}
+	gl.DeleteBuffers(1, &s.buffer)
+	s.buffer, s.data = 0, nil
+}
@@ -0,0 +1,56 @@
+package glutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestStreamBuffer(t *testing.T) {
+	defer testContext(t)()
+	if NewStreamBuffer(0, 3) != nil || NewStreamBuffer(16, 0) != nil {
+		t.Error("NewStreamBuffer accepted a non-positive size")
+	}
+	const regionSize, regions = 16, 3
+	s := NewStreamBuffer(regionSize, regions)
+	if s == nil {
+		t.Skip("buffer storage is not supported")
+	}
+	defer s.Delete()
+
+	// Five frames wrap around the three regions, so the last region still
+	// holds the third frame.
+	for frame := 0; frame < 5; frame++ {
+		ptr, offset, err := s.Next()
+		if err != nil {
+			t.Fatalf("frame %d: %v", frame, err)
+		}
+		if want := frame % regions * regionSize; offset != want {
+			t.Errorf("frame %d: offset = %d, want %d", frame, offset, want)
+		}
+		v := float32(frame)
+		copy(mappedFloats(ptr, 4), []float32{v, v, v, v})
+		s.Commit()
+	}
+
+	gl.BindBuffer(gl.COPY_READ_BUFFER, s.Buffer())
+	defer gl.BindBuffer(gl.COPY_READ_BUFFER, 0)
+	gl.Finish()
+	want := []float32{3, 3, 3, 3, 4, 4, 4, 4, 2, 2, 2, 2}
+	if got := bufferFloats(gl.COPY_READ_BUFFER, len(want)); !reflect.DeepEqual(got, want) {
+		t.Errorf("stream buffer = %v, want %v", got, want)
+	}
+	checkErrors(t)
+
+	// A fence that can no longer be waited on, here one already deleted,
+	// makes Next fail instead of handing out a region the GPU may be reading.
+	f := NewFence()
+	f.Delete()
+	s.fences[s.current] = f
+	if _, _, err := s.Next(); err == nil {
+		t.Error("Next succeeded although waiting for the region failed")
+	}
+	s.fences[s.current] = 0
+	GetErrors()
+}
@@ -0,0 +1,116 @@
+package glutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// SubroutineFunc is a subroutine function that can be assigned to a
+// subroutine uniform.
+type SubroutineFunc struct {
+	Name  string
+	Index uint32
+}
+
+// Subroutine describes an active subroutine uniform of a shader stage and the
+// subroutine functions compatible with it.
+type Subroutine struct {
+	Name       string
+	Index      uint32
+	Location   int32
+	Compatible []SubroutineFunc
+}
+
+// subroutinesSupported reports whether the current context supports shader
+// subroutines, which are core in OpenGL 4.0.
+func subroutinesSupported() bool {
+	return versionAtLeast(4, 0) || extensionSupported("GL_ARB_shader_subroutine")
+}
+
+// ActiveSubroutines returns the active subroutine uniforms of one stage of a
+// linked program, such as gl.FRAGMENT_SHADER, along with the subroutines that
+// are compatible with each. It returns nil if subroutines are not supported
+// by the context or the stage has no subroutine uniforms.
+func ActiveSubroutines(program uint32, shaderType uint32) []Subroutine {
+	if !subroutinesSupported() {
+		return nil
+	}
+	var count, uniformNameLength, funcNameLength int32
+	gl.GetProgramStageiv(program, shaderType, gl.ACTIVE_SUBROUTINE_UNIFORMS, &count)
+	if count <= 0 {
+		return nil
+	}
+	gl.GetProgramStageiv(program, shaderType, gl.ACTIVE_SUBROUTINE_UNIFORM_MAX_LENGTH, &uniformNameLength)
+	gl.GetProgramStageiv(program, shaderType, gl.ACTIVE_SUBROUTINE_MAX_LENGTH, &funcNameLength)
+	uniformName := make([]uint8, uniformNameLength+1)
+	funcName := make([]uint8, funcNameLength+1)
+
+	subroutines := make([]Subroutine, count)
+	for i := range subroutines {
+		s := &subroutines[i]
+		s.Index = uint32(i)
+		gl.GetActiveSubroutineUniformName(program, shaderType, s.Index, int32(len(uniformName)), nil, &uniformName[0])
+		s.Name = gl.GoStr(&uniformName[0])
+		s.Location = gl.GetSubroutineUniformLocation(program, shaderType, &uniformName[0])
+
+		var numCompatible int32
+		gl.GetActiveSubroutineUniformiv(program, shaderType, s.Index, gl.NUM_COMPATIBLE_SUBROUTINES, &numCompatible)
+		if numCompatible <= 0 {
+			continue
+		}
+		indices := make([]int32, numCompatible)
+		gl.GetActiveSubroutineUniformiv(program, shaderType, s.Index, gl.COMPATIBLE_SUBROUTINES, &indices[0])
+		s.Compatible = make([]SubroutineFunc, numCompatible)
+		for j, index := range indices {
+			gl.GetActiveSubroutineName(program, shaderType, uint32(index), int32(len(funcName)), nil, &funcName[0])
+			s.Compatible[j] = SubroutineFunc{Name: gl.GoStr(&funcName[0]), Index: uint32(index)}
+		}
+	}
+	return subroutines
+}
+
+// SetSubroutines assigns subroutine functions to subroutine uniforms of one
+// stage of the current program, with assignments mapping uniform names to
+// subroutine names.
+//
+// glUniformSubroutinesuiv always sets every subroutine uniform of a stage at
+// once, so uniforms missing from assignments keep their current subroutine
+// instead of being reset. The assignments are applied in a single call.
+func SetSubroutines(shaderType uint32, assignments map[string]string) error {
+	if !subroutinesSupported() {
+		return errors.New("glUniformSubroutinesuiv requires OpenGL 4.0 or GL_ARB_shader_subroutine")
+	}
+	var program int32
+	gl.GetIntegerv(gl.CURRENT_PROGRAM, &program)
+	if program == 0 {
+		return errors.New("no program is current")
+	}
+	var count int32
+	gl.GetProgramStageiv(uint32(program), shaderType, gl.ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS, &count)
+	if count <= 0 {
+		if len(assignments) > 0 {
+			return errors.New("shader stage has no subroutine uniforms")
+		}
+		return nil
+	}
+
+	indices := make([]uint32, count)
+	for location := range indices {
+		gl.GetUniformSubroutineuiv(shaderType, int32(location), &indices[location])
+	}
+	for uniform, subroutine := range assignments {
+		location := gl.GetSubroutineUniformLocation(uint32(program), shaderType, gl.Str(uniform+"\x00"))
+		if location < 0 || location >= count {
+			return fmt.Errorf("unknown subroutine uniform %q", uniform)
+		}
+		index := gl.GetSubroutineIndex(uint32(program), shaderType, gl.Str(subroutine+"\x00"))
+		if index == gl.INVALID_INDEX {
+			return fmt.Errorf("unknown subroutine %q", subroutine)
+		}
+		indices[location] = index
+	}
+	gl.UniformSubroutinesuiv(shaderType, count, &indices[0])
+	return nil
+}
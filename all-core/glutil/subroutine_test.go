@@ -0,0 +1,116 @@
+package glutil
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+const subroutineShader = `#version 400 core
+subroutine vec4 colorFunc();
+subroutine float scaleFunc();
+subroutine(colorFunc) vec4 red() { return vec4(1.0, 0.0, 0.0, 1.0); }
+subroutine(colorFunc) vec4 green() { return vec4(0.0, 1.0, 0.0, 1.0); }
+subroutine(scaleFunc) float dim() { return 0.5; }
+subroutine(scaleFunc) float bright() { return 1.0; }
+subroutine uniform colorFunc color;
+subroutine uniform scaleFunc scale;
+out vec4 fragColor;
+void main() {
+	fragColor = color() * scale();
+}
+`
+
+// newSubroutineProgram links subroutineShader, skipping the test without
+// subroutine support.
+func newSubroutineProgram(t *testing.T) uint32 {
+	t.Helper()
+	if !subroutinesSupported() {
+		t.Skip("shader subroutines are not supported")
+	}
+	program, err := NewProgram(fullscreenVertexShader, subroutineShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return program
+}
+
+func TestActiveSubroutines(t *testing.T) {
+	defer testContext(t)()
+	program := newSubroutineProgram(t)
+	defer gl.DeleteProgram(program)
+
+	got := make(map[string][]string)
+	for _, s := range ActiveSubroutines(program, gl.FRAGMENT_SHADER) {
+		if s.Location < 0 {
+			t.Errorf("subroutine uniform %s has location %d", s.Name, s.Location)
+		}
+		var names []string
+		for _, f := range s.Compatible {
+			if index := gl.GetSubroutineIndex(program, gl.FRAGMENT_SHADER, gl.Str(f.Name+"\x00")); index != f.Index {
+				t.Errorf("subroutine %s has index %d, want %d", f.Name, f.Index, index)
+			}
+			names = append(names, f.Name)
+		}
+		sort.Strings(names)
+		got[s.Name] = names
+	}
+	want := map[string][]string{
+		"color": {"green", "red"},
+		"scale": {"bright", "dim"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ActiveSubroutines returned uniforms %v, want %v", got, want)
+	}
+	for name, funcs := range want {
+		if g := got[name]; len(g) != 2 || g[0] != funcs[0] || g[1] != funcs[1] {
+			t.Errorf("subroutine uniform %s is compatible with %v, want %v", name, g, funcs)
+		}
+	}
+
+	if s := ActiveSubroutines(program, gl.VERTEX_SHADER); s != nil {
+		t.Errorf("vertex stage has subroutine uniforms %+v, want none", s)
+	}
+	checkErrors(t)
+}
+
+func TestSetSubroutines(t *testing.T) {
+	defer testContext(t)()
+	program := newSubroutineProgram(t)
+	defer gl.DeleteProgram(program)
+	gl.UseProgram(program)
+	defer gl.UseProgram(0)
+
+	index := func(name string) uint32 {
+		return gl.GetSubroutineIndex(program, gl.FRAGMENT_SHADER, gl.Str(name+"\x00"))
+	}
+	current := func(uniform string) uint32 {
+		location := gl.GetSubroutineUniformLocation(program, gl.FRAGMENT_SHADER, gl.Str(uniform+"\x00"))
+		var v uint32
+		gl.GetUniformSubroutineuiv(gl.FRAGMENT_SHADER, location, &v)
+		return v
+	}
+
+	if err := SetSubroutines(gl.FRAGMENT_SHADER, map[string]string{"color": "green", "scale": "dim"}); err != nil {
+		t.Fatal(err)
+	}
+	if current("color") != index("green") || current("scale") != index("dim") {
+		t.Errorf("subroutines are %d, %d; want green (%d) and dim (%d)", current("color"), current("scale"), index("green"), index("dim"))
+	}
+	// Uniforms left out keep their subroutine.
+	if err := SetSubroutines(gl.FRAGMENT_SHADER, map[string]string{"color": "red"}); err != nil {
+		t.Fatal(err)
+	}
+	if current("color") != index("red") || current("scale") != index("dim") {
+		t.Errorf("subroutines are %d, %d; want red (%d) and dim (%d)", current("color"), current("scale"), index("red"), index("dim"))
+	}
+
+	if err := SetSubroutines(gl.FRAGMENT_SHADER, map[string]string{"missing": "red"}); err == nil {
+		t.Error("SetSubroutines accepted an unknown subroutine uniform")
+	}
+	if err := SetSubroutines(gl.FRAGMENT_SHADER, map[string]string{"color": "missing"}); err == nil {
+		t.Error("SetSubroutines accepted an unknown subroutine")
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,58 @@
+package glutil
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// ErrTimeout is returned when the GPU does not signal a fence in time.
+var ErrTimeout = errors.New("timed out waiting for GPU")
+
+// Fence is a sync object that is signaled once the GPU has completed all
+// commands issued before it.
+type Fence uintptr
+
+// NewFence inserts a fence into the command stream.
+func NewFence() Fence {
+	return Fence(gl.FenceSync(gl.SYNC_GPU_COMMANDS_COMPLETE, 0))
+}
+
+// Wait blocks until the fence is signaled or the timeout expires, in which case
+// ErrTimeout is returned. Pending commands are flushed so that the fence is
+// guaranteed to be signaled eventually.
+func (f Fence) Wait(timeout time.Duration) error {
+	if timeout < 0 {
+		timeout = 0
+	}
+	switch gl.ClientWaitSync(uintptr(f), gl.SYNC_FLUSH_COMMANDS_BIT, uint64(timeout)) {
+	case gl.ALREADY_SIGNALED, gl.CONDITION_SATISFIED:
+		return nil
+	case gl.TIMEOUT_EXPIRED:
+		return ErrTimeout
+	default:
+		return errors.New("glClientWaitSync failed")
+	}
+}
+
+// Signaled reports whether the fence has been signaled, without blocking.
+func (f Fence) Signaled() bool {
+	var status int32
+	gl.GetSynciv(uintptr(f), gl.SYNC_STATUS, 1, nil, &status)
+	return status == gl.SIGNALED
+}
+
+// Delete deletes the sync object.
+func (f Fence) Delete() {
+	gl.DeleteSync(uintptr(f))
+}
+
+// FinishWithTimeout waits for the GPU to complete all issued commands like
+// glFinish, but gives up after d and returns ErrTimeout instead of blocking
+// forever, for example on a hung or lost GPU.
+func FinishWithTimeout(d time.Duration) error {
+	f := NewFence()
+	defer f.Delete()
+	return f.Wait(d)
+}
@@ -0,0 +1,34 @@
+package glutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestFinishWithTimeout(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFBO := newTestFramebuffer(t, 1)
+	defer deleteFBO()
+	gl.ClearColor(0.5, 0.5, 0.5, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.ClearColor(0, 0, 0, 0)
+	if err := FinishWithTimeout(10 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	checkErrors(t)
+}
+
+func TestFence(t *testing.T) {
+	defer testContext(t)()
+	f := NewFence()
+	defer f.Delete()
+	if err := f.Wait(10 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !f.Signaled() {
+		t.Error("fence is not signaled after Wait returned")
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,32 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// SupportsTessellation reports whether tessellation control and evaluation
+// shaders are available, which requires OpenGL 4.0 or
+// GL_ARB_tessellation_shader as well as a loaded glPatchParameteri.
+func SupportsTessellation() bool {
+	return (versionAtLeast(4, 0) || extensionSupported("GL_ARB_tessellation_shader")) &&
+		gl.FunctionLoaded("glPatchParameteri")
+}
+
+// SetPatchVertices sets the number of vertices making up each patch drawn with
+// gl.PATCHES, which must match the input patch size the tessellation control
+// shader expects. It defaults to 3. SetPatchVertices is a no-op if
+// tessellation is not supported.
+func SetPatchVertices(n int32) {
+	if !SupportsTessellation() {
+		return
+	}
+	gl.PatchParameteri(gl.PATCH_VERTICES, n)
+}
+
+// MaxTessGenLevel returns the maximum tessellation level the tessellation
+// primitive generator supports, which is at least 64. It returns zero if
+// tessellation is not supported.
+func MaxTessGenLevel() int32 {
+	if !SupportsTessellation() {
+		return 0
+	}
+	return getInteger(gl.MAX_TESS_GEN_LEVEL)
+}
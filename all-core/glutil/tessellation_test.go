@@ -0,0 +1,26 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSetPatchVertices(t *testing.T) {
+	defer testContext(t)()
+	if !SupportsTessellation() {
+		if got := MaxTessGenLevel(); got != 0 {
+			t.Errorf("MaxTessGenLevel() = %d without tessellation, want 0", got)
+		}
+		t.Skip("requires OpenGL 4.0 or GL_ARB_tessellation_shader")
+	}
+	defer SetPatchVertices(3)
+	SetPatchVertices(4)
+	if got := getInteger(gl.PATCH_VERTICES); got != 4 {
+		t.Errorf("PATCH_VERTICES = %d, want 4", got)
+	}
+	if got := MaxTessGenLevel(); got < 64 {
+		t.Errorf("MaxTessGenLevel() = %d, want at least 64", got)
+	}
+	checkErrors(t)
+}
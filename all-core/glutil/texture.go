@@ -0,0 +1,282 @@
+package glutil
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// BindImage binds a level of a texture to an image unit for use with image
+// load/store, most commonly from a compute shader.
+//
+// The access argument must be one of gl.READ_ONLY, gl.WRITE_ONLY, or
+// gl.READ_WRITE. The format must be a format supported by image load/store,
+// such as gl.RGBA8 or gl.R32F. BindImage requires OpenGL 4.2.
+func BindImage(unit uint32, texture uint32, level int32, layered bool, layer int32, access uint32, format uint32) error {
+	if err := requireVersion("glBindImageTexture", 4, 2); err != nil {
+		return err
+	}
+	switch access {
+	case gl.READ_ONLY, gl.WRITE_ONLY, gl.READ_WRITE:
+	default:
+		return fmt.Errorf("invalid image access 0x%X; must be READ_ONLY, WRITE_ONLY or READ_WRITE", access)
+	}
+	gl.BindImageTexture(unit, texture, level, layered, layer, access, format)
+	return nil
+}
+
+// SetSeamlessCubemaps enables or disables seamless filtering across cube map
+// faces. It is disabled by default, which produces visible seams along face
+// edges when sampling cube maps with linear filtering.
+func SetSeamlessCubemaps(enabled bool) {
+	setEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS, enabled)
+}
+
+// SeamlessCubemapsEnabled reports whether seamless cube map filtering is
+// enabled.
+func SeamlessCubemapsEnabled() bool {
+	return gl.IsEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS)
+}
+
+// BoundTextures returns the 2D texture bound to each texture unit, keyed by
+// unit number (0 for gl.TEXTURE0). Units without a 2D texture bound are
+// omitted. The active texture unit is restored on return.
+func BoundTextures() map[uint32]uint32 {
+	var units, active int32
+	gl.GetIntegerv(gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS, &units)
+	gl.GetIntegerv(gl.ACTIVE_TEXTURE, &active)
+	defer gl.ActiveTexture(uint32(active))
+
+	bound := make(map[uint32]uint32)
+	for unit := uint32(0); unit < uint32(units); unit++ {
+		gl.ActiveTexture(gl.TEXTURE0 + unit)
+		var tex int32
+		gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &tex)
+		if tex != 0 {
+			bound[unit] = uint32(tex)
+		}
+	}
+	return bound
+}
+
+// NewImmutableTexture2D creates a 2D texture with immutable storage for the
+// given number of mipmap levels and uploads data, if any, to level 0. The
+// format and xtype describe data as for glTexSubImage2D, for example gl.RGBA
+// and gl.UNSIGNED_BYTE.
+//
+// Immutable storage requires OpenGL 4.2 or GL_ARB_texture_storage. On older
+// contexts each level is allocated with glTexImage2D instead, and
+// gl.TEXTURE_MAX_LEVEL limits the texture to the requested levels. The
+// previously bound 2D texture is restored on return.
+func NewImmutableTexture2D(levels, internalFormat, width, height int32, data []byte, format, xtype uint32) (uint32, error) {
+	if levels < 1 {
+		return 0, fmt.Errorf("invalid number of texture levels %d", levels)
+	}
+	if width < 1 || height < 1 {
+		return 0, fmt.Errorf("invalid texture size %dx%d", width, height)
+	}
+
+	var prev int32
+	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &prev)
+	defer gl.BindTexture(gl.TEXTURE_2D, uint32(prev))
+
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	if versionAtLeast(4, 2) || extensionSupported("GL_ARB_texture_storage") {
+		gl.TexStorage2D(gl.TEXTURE_2D, levels, uint32(internalFormat), width, height)
+		if len(data) > 0 {
+			gl.TexSubImage2D(gl.TEXTURE_2D, 0, 0, 0, width, height, format, xtype, gl.Ptr(data))
+		}
+		return tex, nil
+	}
+
+	w, h := width, height
+	for level := int32(0); level < levels; level++ {
+		var pixels unsafe.Pointer
+		if level == 0 && len(data) > 0 {
+			pixels = gl.Ptr(data)
+		}
+		gl.TexImage2D(gl.TEXTURE_2D, level, internalFormat, w, h, 0, format, xtype, pixels)
+		w, h = maxInt32(w/2, 1), maxInt32(h/2, 1)
+	}
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAX_LEVEL, levels-1)
+	return tex, nil
+}
+
+func maxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// textureBindings maps texture targets to the state holding their binding.
+var textureBindings = map[uint32]uint32{
+	gl.TEXTURE_1D:                   gl.TEXTURE_BINDING_1D,
+	gl.TEXTURE_1D_ARRAY:             gl.TEXTURE_BINDING_1D_ARRAY,
+	gl.TEXTURE_2D:                   gl.TEXTURE_BINDING_2D,
+	gl.TEXTURE_2D_ARRAY:             gl.TEXTURE_BINDING_2D_ARRAY,
+	gl.TEXTURE_2D_MULTISAMPLE:       gl.TEXTURE_BINDING_2D_MULTISAMPLE,
+	gl.TEXTURE_2D_MULTISAMPLE_ARRAY: gl.TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY,
+	gl.TEXTURE_3D:                   gl.TEXTURE_BINDING_3D,
+	gl.TEXTURE_BUFFER:               gl.TEXTURE_BINDING_BUFFER,
+	gl.TEXTURE_CUBE_MAP:             gl.TEXTURE_BINDING_CUBE_MAP,
+	gl.TEXTURE_CUBE_MAP_ARRAY:       gl.TEXTURE_BINDING_CUBE_MAP_ARRAY,
+	gl.TEXTURE_RECTANGLE:            gl.TEXTURE_BINDING_RECTANGLE,
+}
+
+// GenerateMipmapChecked generates mipmaps for the texture bound to target like
+// glGenerateMipmap, but first checks that the internal format of its base
+// level is filterable. Mipmap generation is undefined for formats that are
+// not, such as integer formats, and commonly results in black mipmaps.
+//
+// The check requires OpenGL 4.3 or GL_ARB_internalformat_query2; without it
+// an error is returned for integer formats only.
+func GenerateMipmapChecked(target uint32) error {
+	if _, ok := textureBindings[target]; !ok {
+		return fmt.Errorf("invalid texture target 0x%X", target)
+	}
+	if getInteger(textureBindings[target]) == 0 {
+		return errors.New("no texture bound")
+	}
+	levelTarget := target
+	if target == gl.TEXTURE_CUBE_MAP {
+		levelTarget = gl.TEXTURE_CUBE_MAP_POSITIVE_X
+	}
+	var base, format int32
+	gl.GetTexParameteriv(target, gl.TEXTURE_BASE_LEVEL, &base)
+	gl.GetTexLevelParameteriv(levelTarget, base, gl.TEXTURE_INTERNAL_FORMAT, &format)
+
+	if internalFormatQuerySupported() {
+		if !IsFilterable(uint32(format)) {
+			return fmt.Errorf("cannot generate mipmaps for non-filterable internal format 0x%X", format)
+		}
+	} else {
+		var componentType int32
+		gl.GetTexLevelParameteriv(levelTarget, base, gl.TEXTURE_RED_TYPE, &componentType)
+		if componentType == gl.INT || componentType == gl.UNSIGNED_INT {
+			return fmt.Errorf("cannot generate mipmaps for integer internal format 0x%X", format)
+		}
+	}
+	gl.GenerateMipmap(target)
+	return nil
+}
+
+// CopyTexture2D copies a width by height region from level 0 of the 2D
+// texture src to level 0 of the 2D texture dst, both starting at the origin.
+// The textures must have compatible internal formats and be complete, so a
+// texture without mipmaps needs a minification filter that does not use them.
+//
+// The copy uses glCopyImageSubData, which requires OpenGL 4.3 or
+// GL_ARB_copy_image. On older contexts it falls back to blitting between two
+// temporary framebuffers, which requires both textures to be color
+// renderable. The framebuffer bindings and scissor test are restored
+// afterwards.
+func CopyTexture2D(src, dst uint32, width, height int32) error {
+	if versionAtLeast(4, 3) || extensionSupported("GL_ARB_copy_image") {
+		gl.CopyImageSubData(src, gl.TEXTURE_2D, 0, 0, 0, 0, dst, gl.TEXTURE_2D, 0, 0, 0, 0, width, height, 1)
+		return nil
+	}
+	return blitTexture2D(src, dst, width, height)
+}
+
+// blitTexture2D implements the fallback of CopyTexture2D.
+func blitTexture2D(src, dst uint32, width, height int32) error {
+	prevRead := getInteger(gl.READ_FRAMEBUFFER_BINDING)
+	prevDraw := getInteger(gl.DRAW_FRAMEBUFFER_BINDING)
+	defer func() {
+		gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(prevRead))
+		gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(prevDraw))
+	}()
+
+	var fbos [2]uint32
+	gl.GenFramebuffers(2, &fbos[0])
+	defer gl.DeleteFramebuffers(2, &fbos[0])
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, fbos[0])
+	gl.FramebufferTexture2D(gl.READ_FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, src, 0)
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, fbos[1])
+	gl.FramebufferTexture2D(gl.DRAW_FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, dst, 0)
+	for _, target := range []uint32{gl.READ_FRAMEBUFFER, gl.DRAW_FRAMEBUFFER} {
+		if status := gl.CheckFramebufferStatus(target); status != gl.FRAMEBUFFER_COMPLETE {
+			return fmt.Errorf("cannot copy texture through incomplete framebuffer: status 0x%X", status)
+		}
+	}
+
+	// The scissor test applies to blits and would make the copy partial.
+	scissor := gl.IsEnabled(gl.SCISSOR_TEST)
+	gl.Disable(gl.SCISSOR_TEST)
+	defer setEnabled(gl.SCISSOR_TEST, scissor)
+
+	gl.BlitFramebuffer(0, 0, width, height, 0, 0, width, height, gl.COLOR_BUFFER_BIT, gl.NEAREST)
+	return nil
+}
+
+// SolidColorTexture creates a 1x1 RGBA texture of the given color, as used for
+// placeholder textures such as plain white or a flat normal map. It uses
+// nearest filtering and clamps to the edge. The previously bound 2D texture is
+// restored on return.
+func SolidColorTexture(r, g, b, a uint8) uint32 {
+	prev := getInteger(gl.TEXTURE_BINDING_2D)
+	defer gl.BindTexture(gl.TEXTURE_2D, uint32(prev))
+
+	pixel := []uint8{r, g, b, a}
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 1, 1, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixel))
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.NEAREST)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.NEAREST)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	return tex
+}
+
+// SamplerBinding holds the textures and sampler object bound to one texture
+// unit.
+type SamplerBinding struct {
+	Unit           uint32 // Unit number, 0 for gl.TEXTURE0.
+	Texture2D      uint32
+	Texture2DArray uint32
+	Texture3D      uint32
+	TextureCube    uint32
+	Sampler        uint32
+}
+
+// SamplerBindings returns the bindings of every texture unit that has at least
+// one texture or sampler object bound, ordered by unit. Unlike BoundTextures
+// it covers several texture targets as well as sampler objects. The active
+// texture unit is restored on return.
+func SamplerBindings() []SamplerBinding {
+	units := getInteger(gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS)
+	active := getInteger(gl.ACTIVE_TEXTURE)
+	defer gl.ActiveTexture(uint32(active))
+
+	var bindings []SamplerBinding
+	for unit := uint32(0); unit < uint32(units); unit++ {
+		gl.ActiveTexture(gl.TEXTURE0 + unit)
+		b := SamplerBinding{
+			Unit:           unit,
+			Texture2D:      uint32(getInteger(gl.TEXTURE_BINDING_2D)),
+			Texture2DArray: uint32(getInteger(gl.TEXTURE_BINDING_2D_ARRAY)),
+			Texture3D:      uint32(getInteger(gl.TEXTURE_BINDING_3D)),
+			TextureCube:    uint32(getInteger(gl.TEXTURE_BINDING_CUBE_MAP)),
+			Sampler:        uint32(getInteger(gl.SAMPLER_BINDING)),
+		}
+		if b != (SamplerBinding{Unit: unit}) {
+			bindings = append(bindings, b)
+		}
+	}
+	return bindings
+}
+
+// EnableDepthCompare enables depth comparison for the depth texture bound to
+// target, so that shadow samplers such as sampler2DShadow return the result
+// of comparing the reference value against the stored depth using
+// compareFunc, typically gl.LEQUAL. Without it shadow lookups are undefined.
+func EnableDepthCompare(target uint32, compareFunc uint32) {
+	gl.TexParameteri(target, gl.TEXTURE_COMPARE_MODE, gl.COMPARE_REF_TO_TEXTURE)
+	gl.TexParameteri(target, gl.TEXTURE_COMPARE_FUNC, int32(compareFunc))
+}
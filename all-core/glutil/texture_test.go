@@ -0,0 +1,301 @@
+package glutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// newTestTexture creates and binds an empty 2D RGBA8 texture of the given
+// size, which the returned function deletes. It is made complete by using
+// linear filtering without mipmaps.
+func newTestTexture(t *testing.T, width, height int32) (tex uint32, deleteFn func()) {
+	t.Helper()
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, width, height, 0, gl.RGBA, gl.UNSIGNED_BYTE, nil)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	return tex, func() {
+		gl.BindTexture(gl.TEXTURE_2D, 0)
+		gl.DeleteTextures(1, &tex)
+	}
+}
+
+func texParameter(target, pname uint32) int32 {
+	var v int32
+	gl.GetTexParameteriv(target, pname, &v)
+	return v
+}
+
+// newTestTextureData creates a 2D RGBA8 texture holding pixels, which the
+// returned function deletes. The 2D texture binding is left at zero.
+func newTestTextureData(t *testing.T, width, height int32, pixels []byte) (tex uint32, deleteFn func()) {
+	t.Helper()
+	tex, deleteFn = newTestTexture(t, width, height)
+	gl.TexSubImage2D(gl.TEXTURE_2D, 0, 0, 0, width, height, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+	gl.BindTexture(gl.TEXTURE_2D, 0)
+	return tex, deleteFn
+}
+
+// textureData reads back level 0 of the 2D RGBA8 texture tex.
+func textureData(t *testing.T, tex uint32) []byte {
+	t.Helper()
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	data, err := GetTexImageRobust(gl.TEXTURE_2D, 0, gl.RGBA, gl.UNSIGNED_BYTE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestCopyTexture2D(t *testing.T) {
+	defer testContext(t)()
+	pixels := make([]byte, 8*8*4)
+	for i := range pixels {
+		pixels[i] = byte(i)
+	}
+	src, deleteSrc := newTestTextureData(t, 8, 8, pixels)
+	defer deleteSrc()
+
+	for _, copyFn := range []struct {
+		name string
+		copy func(src, dst uint32, width, height int32) error
+	}{
+		{"CopyTexture2D", CopyTexture2D},
+		{"blitTexture2D", blitTexture2D},
+	} {
+		dst, deleteDst := newTestTextureData(t, 8, 8, make([]byte, len(pixels)))
+		// A scissor test must not clip the blit fallback.
+		gl.Enable(gl.SCISSOR_TEST)
+		gl.Scissor(0, 0, 1, 1)
+		err := copyFn.copy(src, dst, 8, 8)
+		if !gl.IsEnabled(gl.SCISSOR_TEST) {
+			t.Errorf("%s disabled the scissor test", copyFn.name)
+		}
+		gl.Disable(gl.SCISSOR_TEST)
+		gl.Scissor(0, 0, 64, 64)
+		if err != nil {
+			t.Errorf("%s: %v", copyFn.name, err)
+		} else if got := textureData(t, dst); !bytes.Equal(got, pixels) {
+			t.Errorf("%s copied %v, want %v", copyFn.name, got, pixels)
+		}
+		deleteDst()
+	}
+	checkErrors(t)
+}
+
+const fillImageShader = `#version 430 core
+layout(local_size_x = 1) in;
+layout(rgba8, binding = 0) uniform writeonly image2D img;
+void main() {
+	imageStore(img, ivec2(gl_GlobalInvocationID.xy), vec4(1.0, 0.0, 0.0, 1.0));
+}
+`
+
+func TestBindImage(t *testing.T) {
+	defer testContext(t)()
+	requireContextVersion(t, 4, 3)
+	tex, deleteTex := newTestTextureData(t, 2, 2, make([]byte, 2*2*4))
+	defer deleteTex()
+
+	if err := BindImage(0, tex, 0, false, 0, gl.RGBA8, gl.RGBA8); err == nil {
+		t.Error("BindImage accepted an invalid access mode")
+	}
+	if err := BindImage(0, tex, 0, false, 0, gl.WRITE_ONLY, gl.RGBA8); err != nil {
+		t.Fatal(err)
+	}
+	defer gl.BindImageTexture(0, 0, 0, false, 0, gl.READ_ONLY, gl.RGBA8)
+
+	program, err := linkProgram(shaderSource{gl.COMPUTE_SHADER, fillImageShader})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	WithProgram(program, func() {
+		gl.DispatchCompute(2, 2, 1)
+	})
+	gl.MemoryBarrier(gl.TEXTURE_UPDATE_BARRIER_BIT)
+
+	want := bytes.Repeat([]byte{255, 0, 0, 255}, 2*2)
+	if got := textureData(t, tex); !bytes.Equal(got, want) {
+		t.Errorf("image after dispatch = %v, want %v", got, want)
+	}
+	checkErrors(t)
+}
+
+func TestSetSeamlessCubemaps(t *testing.T) {
+	defer testContext(t)()
+	defer setEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS, gl.IsEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS))
+	for _, enabled := range []bool{true, false} {
+		SetSeamlessCubemaps(enabled)
+		if got := gl.IsEnabled(gl.TEXTURE_CUBE_MAP_SEAMLESS); got != enabled {
+			t.Errorf("SetSeamlessCubemaps(%v): IsEnabled(TEXTURE_CUBE_MAP_SEAMLESS) = %v", enabled, got)
+		}
+		if got := SeamlessCubemapsEnabled(); got != enabled {
+			t.Errorf("SetSeamlessCubemaps(%v): SeamlessCubemapsEnabled() = %v", enabled, got)
+		}
+	}
+	checkErrors(t)
+}
+
+func TestBoundTextures(t *testing.T) {
+	defer testContext(t)()
+	var tex [2]uint32
+	gl.GenTextures(2, &tex[0])
+	defer gl.DeleteTextures(2, &tex[0])
+	gl.ActiveTexture(gl.TEXTURE2)
+	gl.BindTexture(gl.TEXTURE_2D, tex[0])
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	gl.ActiveTexture(gl.TEXTURE5)
+	gl.BindTexture(gl.TEXTURE_2D, tex[1])
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	defer gl.ActiveTexture(gl.TEXTURE0)
+
+	bound := BoundTextures()
+	if len(bound) != 2 || bound[2] != tex[0] || bound[5] != tex[1] {
+		t.Errorf("BoundTextures() = %v, want map[2:%d 5:%d]", bound, tex[0], tex[1])
+	}
+	if active := getInteger(gl.ACTIVE_TEXTURE); active != gl.TEXTURE5 {
+		t.Errorf("active texture unit after BoundTextures = 0x%X, want TEXTURE5", active)
+	}
+	checkErrors(t)
+}
+
+func TestNewImmutableTexture2D(t *testing.T) {
+	defer testContext(t)()
+	pixels := bytes.Repeat([]byte{1, 2, 3, 4}, 4*4)
+	tex, err := NewImmutableTexture2D(3, gl.RGBA8, 4, 4, pixels, gl.RGBA, gl.UNSIGNED_BYTE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteTextures(1, &tex)
+	if bound := getInteger(gl.TEXTURE_BINDING_2D); bound != 0 {
+		t.Errorf("2D texture binding after NewImmutableTexture2D = %d, want 0", bound)
+	}
+
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	if versionAtLeast(4, 2) || extensionSupported("GL_ARB_texture_storage") {
+		if immutable := texParameter(gl.TEXTURE_2D, gl.TEXTURE_IMMUTABLE_FORMAT); immutable != gl.TRUE {
+			t.Error("TEXTURE_IMMUTABLE_FORMAT is not set")
+		}
+		if levels := texParameter(gl.TEXTURE_2D, gl.TEXTURE_IMMUTABLE_LEVELS); levels != 3 {
+			t.Errorf("TEXTURE_IMMUTABLE_LEVELS = %d, want 3", levels)
+		}
+	}
+	if got := textureData(t, tex); !bytes.Equal(got, pixels) {
+		t.Errorf("level 0 = %v, want %v", got, pixels)
+	}
+
+	if _, err := NewImmutableTexture2D(0, gl.RGBA8, 4, 4, nil, gl.RGBA, gl.UNSIGNED_BYTE); err == nil {
+		t.Error("NewImmutableTexture2D accepted zero levels")
+	}
+	checkErrors(t)
+}
+
+func TestGenerateMipmapChecked(t *testing.T) {
+	defer testContext(t)()
+	_, deleteTex := newTestTexture(t, 4, 4)
+	defer deleteTex()
+	if err := GenerateMipmapChecked(gl.TEXTURE_2D); err != nil {
+		t.Fatalf("GenerateMipmapChecked on an RGBA8 texture: %v", err)
+	}
+	var width int32
+	gl.GetTexLevelParameteriv(gl.TEXTURE_2D, 2, gl.TEXTURE_WIDTH, &width)
+	if width != 1 {
+		t.Errorf("mipmap level 2 has width %d, want 1", width)
+	}
+
+	var integer uint32
+	gl.GenTextures(1, &integer)
+	defer gl.DeleteTextures(1, &integer)
+	gl.BindTexture(gl.TEXTURE_2D, integer)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8UI, 4, 4, 0, gl.RGBA_INTEGER, gl.UNSIGNED_BYTE, nil)
+	if err := GenerateMipmapChecked(gl.TEXTURE_2D); err == nil {
+		t.Error("GenerateMipmapChecked accepted an integer texture")
+	}
+
+	gl.BindTexture(gl.TEXTURE_2D, 0)
+	if err := GenerateMipmapChecked(gl.TEXTURE_2D); err == nil {
+		t.Error("GenerateMipmapChecked succeeded without a bound texture")
+	}
+	checkErrors(t)
+}
+
+func TestSolidColorTexture(t *testing.T) {
+	defer testContext(t)()
+	tex := SolidColorTexture(255, 0, 0, 255)
+	defer gl.DeleteTextures(1, &tex)
+	if bound := getInteger(gl.TEXTURE_BINDING_2D); bound != 0 {
+		t.Errorf("2D texture binding after SolidColorTexture = %d, want 0", bound)
+	}
+	if got := textureData(t, tex); !bytes.Equal(got, []byte{255, 0, 0, 255}) {
+		t.Errorf("texture holds %v, want [255 0 0 255]", got)
+	}
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	if filter := texParameter(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER); filter != gl.NEAREST {
+		t.Errorf("TEXTURE_MIN_FILTER = 0x%X, want NEAREST", filter)
+	}
+	if wrap := texParameter(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S); wrap != gl.CLAMP_TO_EDGE {
+		t.Errorf("TEXTURE_WRAP_S = 0x%X, want CLAMP_TO_EDGE", wrap)
+	}
+	checkErrors(t)
+}
+
+func TestSamplerBindings(t *testing.T) {
+	defer testContext(t)()
+	var tex [2]uint32
+	gl.GenTextures(2, &tex[0])
+	defer gl.DeleteTextures(2, &tex[0])
+	var sampler uint32
+	gl.GenSamplers(1, &sampler)
+	defer gl.DeleteSamplers(1, &sampler)
+
+	gl.ActiveTexture(gl.TEXTURE1)
+	gl.BindTexture(gl.TEXTURE_2D, tex[0])
+	gl.BindTexture(gl.TEXTURE_CUBE_MAP, tex[1])
+	defer func() {
+		gl.ActiveTexture(gl.TEXTURE1)
+		gl.BindTexture(gl.TEXTURE_2D, 0)
+		gl.BindTexture(gl.TEXTURE_CUBE_MAP, 0)
+		gl.BindSampler(3, 0)
+		gl.ActiveTexture(gl.TEXTURE0)
+	}()
+	gl.BindSampler(3, sampler)
+	gl.ActiveTexture(gl.TEXTURE2)
+
+	got := SamplerBindings()
+	want := []SamplerBinding{
+		{Unit: 1, Texture2D: tex[0], TextureCube: tex[1]},
+		{Unit: 3, Sampler: sampler},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("SamplerBindings() = %+v, want %+v", got, want)
+	}
+	if active := getInteger(gl.ACTIVE_TEXTURE); active != gl.TEXTURE2 {
+		t.Errorf("active texture unit after SamplerBindings = 0x%X, want TEXTURE2", active)
+	}
+	checkErrors(t)
+}
+
+func TestEnableDepthCompare(t *testing.T) {
+	defer testContext(t)()
+	var tex uint32
+	gl.GenTextures(1, &tex)
+	defer gl.DeleteTextures(1, &tex)
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	defer gl.BindTexture(gl.TEXTURE_2D, 0)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.DEPTH_COMPONENT24, 4, 4, 0, gl.DEPTH_COMPONENT, gl.UNSIGNED_INT, nil)
+
+	EnableDepthCompare(gl.TEXTURE_2D, gl.GEQUAL)
+	if mode := texParameter(gl.TEXTURE_2D, gl.TEXTURE_COMPARE_MODE); mode != gl.COMPARE_REF_TO_TEXTURE {
+		t.Errorf("TEXTURE_COMPARE_MODE = 0x%X, want COMPARE_REF_TO_TEXTURE", mode)
+	}
+	if fn := texParameter(gl.TEXTURE_2D, gl.TEXTURE_COMPARE_FUNC); fn != gl.GEQUAL {
+		t.Errorf("TEXTURE_COMPARE_FUNC = 0x%X, want GEQUAL", fn)
+	}
+	checkErrors(t)
+}
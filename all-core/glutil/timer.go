@@ -0,0 +1,111 @@
+package glutil
+
+import (
+	"time"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// gpuDisjointEXT is the state of GL_EXT_disjoint_timer_query reporting timer
+// disjoint events. The extension is only defined for OpenGL ES, so the all-core
+// bindings do not define it.
+const gpuDisjointEXT = 0x8FBB
+
+// TimerDisjoint reports whether a disruptive event, such as a GPU clock
+// change, occurred since the previous call, in which case timer query results
+// obtained in between are invalid. Querying the state resets it.
+//
+// The state is only exposed through GL_EXT_disjoint_timer_query, which desktop
+// drivers rarely advertise since their timers are not affected; without it
+// TimerDisjoint always reports false.
+func TimerDisjoint() bool {
+	if !extensionSupported("GL_EXT_disjoint_timer_query") {
+		return false
+	}
+	return getInteger(gpuDisjointEXT) != 0
+}
+
+// timerDepth is the number of TimePass calls in progress. Only the outermost
+// one has a gl.TIME_ELAPSED query active, since those cannot be nested, and
+// only it resets the disjoint state.
+var timerDepth int
+
+// timerDisjointSeen records that a disjoint event occurred during the
+// outermost TimePass call in progress. Querying the state resets it, so
+// nested calls remember events here for their enclosing calls.
+var timerDisjointSeen bool
+
+// passDisjoint reports whether a disjoint event occurred since the outermost
+// TimePass call in progress started.
+func passDisjoint() bool {
+	if TimerDisjoint() {
+		timerDisjointSeen = true
+	}
+	return timerDisjointSeen
+}
+
+// TimePass runs fn and returns how long the GPU took to execute the commands
+// it issued. It blocks until the result is available, so it is meant for
+// ad-hoc profiling rather than every frame.
+//
+// gl.TIME_ELAPSED queries cannot be nested, so TimePass calls made from
+// within fn fall back to timestamp queries, which also include any time the
+// GPU spent idle in between. If debug output is available, the commands are
+// additionally wrapped in a debug group called name, making them easy to find
+// in GPU captures.
+//
+// Timer queries require OpenGL 3.3 or GL_ARB_timer_query. Without them fn is
+// still run but TimePass returns zero, as it does when a disjoint event (see
+// TimerDisjoint) makes the measurement invalid. Nested calls also return zero
+// if the event occurred earlier during the outermost call.
+func TimePass(name string, fn func()) time.Duration {
+	if !versionAtLeast(3, 3) && !extensionSupported("GL_ARB_timer_query") {
+		fn()
+		return 0
+	}
+	if versionAtLeast(4, 3) {
+		gl.PushDebugGroup(gl.DEBUG_SOURCE_APPLICATION, 0, int32(len(name)), gl.Str(name+"\x00"))
+		defer gl.PopDebugGroup()
+	}
+
+	nested := timerDepth > 0
+	if !nested {
+		// Reset the disjoint state, so that only events during fn invalidate
+		// the result.
+		TimerDisjoint()
+		timerDisjointSeen = false
+	}
+	timerDepth++
+	defer func() { timerDepth-- }()
+
+	if nested {
+		var queries [2]uint32
+		gl.GenQueries(2, &queries[0])
+		defer gl.DeleteQueries(2, &queries[0])
+		gl.QueryCounter(queries[0], gl.TIMESTAMP)
+		fn()
+		gl.QueryCounter(queries[1], gl.TIMESTAMP)
+		var start, end uint64
+		gl.GetQueryObjectui64v(queries[0], gl.QUERY_RESULT, &start)
+		gl.GetQueryObjectui64v(queries[1], gl.QUERY_RESULT, &end)
+		if passDisjoint() {
+			return 0
+		}
+		return time.Duration(end - start)
+	}
+
+	var query uint32
+	gl.GenQueries(1, &query)
+	defer gl.DeleteQueries(1, &query)
+	gl.BeginQuery(gl.TIME_ELAPSED, query)
+	func() {
+		defer gl.EndQuery(gl.TIME_ELAPSED)
+		fn()
+	}()
+	var elapsed uint64
+	gl.GetQueryObjectui64v(query, gl.QUERY_RESULT, &elapsed)
+	if passDisjoint() {
+		return 0
+	}
+	return time.Duration(elapsed)
+}
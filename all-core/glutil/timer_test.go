@@ -0,0 +1,62 @@
+package glutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestTimePassNested(t *testing.T) {
+	defer testContext(t)()
+	requireContextVersion(t, 3, 3)
+	defer bindEmptyVAO()()
+	_, deleteFBO := newTestFramebuffer(t, 1)
+	defer deleteFBO()
+
+	var inner, innermost, after time.Duration
+	outer := TimePass("outer", func() {
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+		inner = TimePass("inner", func() {
+			gl.Clear(gl.COLOR_BUFFER_BIT)
+			innermost = TimePass("innermost", func() { gl.Clear(gl.COLOR_BUFFER_BIT) })
+		})
+		after = TimePass("after", func() { gl.Clear(gl.COLOR_BUFFER_BIT) })
+	})
+	if timerDepth != 0 {
+		t.Errorf("timerDepth = %d after TimePass returned, want 0", timerDepth)
+	}
+	for _, d := range []time.Duration{outer, inner, innermost, after} {
+		if d < 0 {
+			t.Errorf("TimePass returned negative duration %v", d)
+		}
+	}
+	if outer < inner || inner < innermost {
+		t.Errorf("nested TimePass durations %v, %v, %v are not ordered", outer, inner, innermost)
+	}
+	checkErrors(t)
+}
+
+func TestTimePassPanic(t *testing.T) {
+	defer testContext(t)()
+	requireContextVersion(t, 3, 3)
+	func() {
+		defer func() { recover() }()
+		TimePass("panic", func() { panic("pass failed") })
+	}()
+	if timerDepth != 0 {
+		t.Errorf("timerDepth = %d after fn panicked, want 0", timerDepth)
+	}
+	// The query must have ended, so another pass can begin one.
+	TimePass("next", func() {})
+	checkErrors(t)
+}
+
+func TestTimerDisjoint(t *testing.T) {
+	defer testContext(t)()
+	disjoint := TimerDisjoint()
+	checkErrors(t)
+	if !extensionSupported("GL_EXT_disjoint_timer_query") && disjoint {
+		t.Error("TimerDisjoint() = true without GL_EXT_disjoint_timer_query")
+	}
+}
@@ -0,0 +1,113 @@
+package glutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// UniformMatrix4 sets a mat4 uniform of the current program from m in
+// column-major order, where m[12], m[13] and m[14] hold the translation. This
+// is the layout used by mathgl and GLSL.
+func UniformMatrix4(location int32, m [16]float32) {
+	gl.UniformMatrix4fv(location, 1, false, &m[0])
+}
+
+// UniformMatrix4RowMajor sets a mat4 uniform of the current program from m in
+// row-major order, where m[3], m[7] and m[11] hold the translation. The matrix
+// is transposed by OpenGL while uploading.
+func UniformMatrix4RowMajor(location int32, m [16]float32) {
+	gl.UniformMatrix4fv(location, 1, true, &m[0])
+}
+
+// UniformMatrix3 sets a mat3 uniform of the current program from m in
+// column-major order, the layout used by mathgl and GLSL.
+func UniformMatrix3(location int32, m [9]float32) {
+	gl.UniformMatrix3fv(location, 1, false, &m[0])
+}
+
+// UniformMatrix3RowMajor sets a mat3 uniform of the current program from m in
+// row-major order. The matrix is transposed by OpenGL while uploading.
+func UniformMatrix3RowMajor(location int32, m [9]float32) {
+	gl.UniformMatrix3fv(location, 1, true, &m[0])
+}
+
+// uniformComponents maps the GLSL types of non-opaque uniforms to the number
+// of components they use. Doubles count as two components each.
+var uniformComponents = map[uint32]int32{
+	gl.FLOAT: 1, gl.FLOAT_VEC2: 2, gl.FLOAT_VEC3: 3, gl.FLOAT_VEC4: 4,
+	gl.INT: 1, gl.INT_VEC2: 2, gl.INT_VEC3: 3, gl.INT_VEC4: 4,
+	gl.UNSIGNED_INT: 1, gl.UNSIGNED_INT_VEC2: 2, gl.UNSIGNED_INT_VEC3: 3, gl.UNSIGNED_INT_VEC4: 4,
+	gl.BOOL: 1, gl.BOOL_VEC2: 2, gl.BOOL_VEC3: 3, gl.BOOL_VEC4: 4,
+	gl.FLOAT_MAT2: 4, gl.FLOAT_MAT3: 9, gl.FLOAT_MAT4: 16,
+	gl.FLOAT_MAT2x3: 6, gl.FLOAT_MAT2x4: 8, gl.FLOAT_MAT3x2: 6,
+	gl.FLOAT_MAT3x4: 12, gl.FLOAT_MAT4x2: 8, gl.FLOAT_MAT4x3: 12,
+	gl.DOUBLE: 2, gl.DOUBLE_VEC2: 4, gl.DOUBLE_VEC3: 6, gl.DOUBLE_VEC4: 8,
+	gl.DOUBLE_MAT2: 8, gl.DOUBLE_MAT3: 18, gl.DOUBLE_MAT4: 32,
+	gl.DOUBLE_MAT2x3: 12, gl.DOUBLE_MAT2x4: 16, gl.DOUBLE_MAT3x2: 12,
+	gl.DOUBLE_MAT3x4: 24, gl.DOUBLE_MAT4x2: 16, gl.DOUBLE_MAT4x3: 24,
+}
+
+// uniformBudgetMargin is the fraction of a uniform limit above which
+// CheckUniformBudget reports a program as near the limit.
+const uniformBudgetMargin = 0.9
+
+// CheckUniformBudget compares the uniforms a linked program uses outside
+// uniform blocks against MaxVertexUniformComponents,
+// MaxFragmentUniformComponents, and MaxUniformLocations. If the program uses
+// more than 90% of any of them, the returned error lists each such limit with
+// the program's usage, so that shaders can be trimmed, or uniforms moved into
+// uniform blocks, before a driver with lower limits fails to link them.
+//
+// Samplers and other opaque uniforms are not counted as components. Which
+// stage uses a uniform is only known from OpenGL 4.3 onwards; on older
+// contexts every uniform is assumed to be used by both stages.
+func CheckUniformBudget(program uint32) error {
+	var count int32
+	gl.GetProgramiv(program, gl.ACTIVE_UNIFORMS, &count)
+	perStage := versionAtLeast(4, 3)
+	var vertex, fragment, locations int32
+	// Only the size and type of each uniform are needed, not its name.
+	var name [1]uint8
+	for i := uint32(0); i < uint32(count); i++ {
+		var block int32
+		gl.GetActiveUniformsiv(program, 1, &i, gl.UNIFORM_BLOCK_INDEX, &block)
+		if block != -1 {
+			continue
+		}
+		var size int32
+		var xtype uint32
+		gl.GetActiveUniform(program, i, int32(len(name)), nil, &size, &xtype, &name[0])
+		locations += size
+		components := uniformComponents[xtype] * size
+		if !perStage {
+			vertex += components
+			fragment += components
+			continue
+		}
+		props := []uint32{gl.REFERENCED_BY_VERTEX_SHADER, gl.REFERENCED_BY_FRAGMENT_SHADER}
+		var referenced [2]int32
+		gl.GetProgramResourceiv(program, gl.UNIFORM, i, int32(len(props)), &props[0], int32(len(referenced)), nil, &referenced[0])
+		if referenced[0] != 0 {
+			vertex += components
+		}
+		if referenced[1] != 0 {
+			fragment += components
+		}
+	}
+
+	var problems []string
+	check := func(what string, used, max int32) {
+		if max > 0 && float64(used) > uniformBudgetMargin*float64(max) {
+			problems = append(problems, fmt.Sprintf("%s: %d of %d", what, used, max))
+		}
+	}
+	check("vertex uniform components", vertex, MaxVertexUniformComponents())
+	check("fragment uniform components", fragment, MaxFragmentUniformComponents())
+	check("uniform locations", locations, MaxUniformLocations())
+	if len(problems) > 0 {
+		return fmt.Errorf("program uses most or all of its uniform budget (%s)", strings.Join(problems, ", "))
+	}
+	return nil
+}
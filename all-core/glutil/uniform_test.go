@@ -0,0 +1,96 @@
+package glutil
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+const matrixShader = `#version 330 core
+uniform mat4 m4;
+uniform mat3 m3;
+out vec4 fragColor;
+void main() {
+	fragColor = m4[0] + vec4(m3[0], 0.0);
+}
+`
+
+func TestUniformMatrix(t *testing.T) {
+	defer testContext(t)()
+	program, err := NewProgram(fullscreenVertexShader, matrixShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	gl.UseProgram(program)
+	defer gl.UseProgram(0)
+	m4 := gl.GetUniformLocation(program, gl.Str("m4\x00"))
+	m3 := gl.GetUniformLocation(program, gl.Str("m3\x00"))
+
+	// A translation by (1, 2, 3), in both layouts.
+	columnMajor4 := [16]float32{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 1, 2, 3, 1}
+	rowMajor4 := [16]float32{1, 0, 0, 1, 0, 1, 0, 2, 0, 0, 1, 3, 0, 0, 0, 1}
+	var got4 [16]float32
+	UniformMatrix4(m4, columnMajor4)
+	gl.GetUniformfv(program, m4, &got4[0])
+	if got4 != columnMajor4 {
+		t.Errorf("UniformMatrix4: read back %v, want %v", got4, columnMajor4)
+	}
+	got4 = [16]float32{}
+	UniformMatrix4RowMajor(m4, rowMajor4)
+	gl.GetUniformfv(program, m4, &got4[0])
+	if got4 != columnMajor4 {
+		t.Errorf("UniformMatrix4RowMajor: read back %v, want %v", got4, columnMajor4)
+	}
+
+	columnMajor3 := [9]float32{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	rowMajor3 := [9]float32{1, 4, 7, 2, 5, 8, 3, 6, 9}
+	var got3 [9]float32
+	UniformMatrix3(m3, columnMajor3)
+	gl.GetUniformfv(program, m3, &got3[0])
+	if got3 != columnMajor3 {
+		t.Errorf("UniformMatrix3: read back %v, want %v", got3, columnMajor3)
+	}
+	got3 = [9]float32{}
+	UniformMatrix3RowMajor(m3, rowMajor3)
+	gl.GetUniformfv(program, m3, &got3[0])
+	if got3 != columnMajor3 {
+		t.Errorf("UniformMatrix3RowMajor: read back %v, want %v", got3, columnMajor3)
+	}
+	checkErrors(t)
+}
+
+func TestCheckUniformBudget(t *testing.T) {
+	defer testContext(t)()
+	program, err := NewProgram(fullscreenVertexShader, matrixShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	if err := CheckUniformBudget(program); err != nil {
+		t.Errorf("CheckUniformBudget of a small program: %v", err)
+	}
+
+	// An array indexed dynamically stays active in full, and uses 95% of the
+	// fragment uniform components.
+	n := MaxFragmentUniformComponents() * 95 / 100 / 4
+	large, err := NewProgram(fullscreenVertexShader, fmt.Sprintf(`#version 330 core
+uniform vec4 values[%d];
+out vec4 fragColor;
+void main() {
+	fragColor = values[int(gl_FragCoord.x) %% %d];
+}
+`, n, n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(large)
+	if err := CheckUniformBudget(large); err == nil {
+		t.Error("CheckUniformBudget accepted a program using 95% of the fragment uniform components")
+	} else if !strings.Contains(err.Error(), "fragment uniform components") {
+		t.Errorf("error %q does not name the fragment uniform components", err)
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,52 @@
+package glutil
+
+import (
+	"fmt"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// contextVersion returns the major and minor OpenGL version of the current
+// context.
+func contextVersion() (major, minor int32) {
+	gl.GetIntegerv(gl.MAJOR_VERSION, &major)
+	gl.GetIntegerv(gl.MINOR_VERSION, &minor)
+	return major, minor
+}
+
+// versionAtLeast reports whether the current context is at least the given
+// OpenGL version.
+func versionAtLeast(major, minor int32) bool {
+	haveMajor, haveMinor := contextVersion()
+	return haveMajor > major || (haveMajor == major && haveMinor >= minor)
+}
+
+// requireVersion returns an error naming fn if the current context is older
+// than the given OpenGL version.
+func requireVersion(fn string, major, minor int32) error {
+	if !versionAtLeast(major, minor) {
+		return fmt.Errorf("%s requires OpenGL %d.%d", fn, major, minor)
+	}
+	return nil
+}
+
+// SupportsVersion reports whether the current context is at least the given
+// OpenGL version and all core functions up to that version were loaded by
+// gl.Init. Some drivers report a version without exposing every entry point
+// it requires, which the reported version alone does not reveal.
+func SupportsVersion(major, minor int) bool {
+	return versionAtLeast(int32(major), int32(minor)) && gl.CoreFunctionsLoaded(major, minor)
+}
+
+// extensionSupported reports whether the current context advertises the named
+// extension, such as "GL_ARB_bindless_texture".
+func extensionSupported(name string) bool {
+	var n int32
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &n)
+	for i := int32(0); i < n; i++ {
+		if gl.GoStr(gl.GetStringi(gl.EXTENSIONS, uint32(i))) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package glutil
+
+import "testing"
+
+func TestSupportsVersion(t *testing.T) {
+	defer testContext(t)()
+	major, minor := contextVersion()
+	for _, v := range []struct {
+		major, minor int32
+		want         bool
+	}{
+		{3, 2, true},
+		{major, minor, true},
+		{major, minor + 1, false},
+		{major + 1, 0, false},
+	} {
+		if got := SupportsVersion(int(v.major), int(v.minor)); got != v.want {
+			t.Errorf("SupportsVersion(%d, %d) = %v on an OpenGL %d.%d context, want %v", v.major, v.minor, got, major, minor, v.want)
+		}
+	}
+	checkErrors(t)
+}
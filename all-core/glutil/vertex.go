@@ -0,0 +1,80 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// AttribSpec describes one vertex attribute sourced from the buffer bound to
+// gl.ARRAY_BUFFER.
+type AttribSpec struct {
+	Index      uint32
+	Size       int32  // Number of components, 1 to 4.
+	Type       uint32 // Component type, e.g. gl.FLOAT.
+	Normalized bool   // Normalize fixed-point values to [0, 1] or [-1, 1].
+	// Integer keeps integer components as integers, for use with int or uint
+	// shader inputs, instead of converting them to floats.
+	Integer bool
+	Stride  int32 // Distance in bytes between consecutive elements.
+	Offset  uintptr
+	// Divisor is the number of instances drawn before the attribute advances
+	// to the next element. Zero, the default, advances it per vertex.
+	Divisor uint32
+}
+
+// Apply enables the attribute in the bound vertex array and points it at the
+// buffer bound to gl.ARRAY_BUFFER, including its divisor.
+func (a AttribSpec) Apply() {
+	gl.EnableVertexAttribArray(a.Index)
+	if a.Integer {
+		gl.VertexAttribIPointerWithOffset(a.Index, a.Size, a.Type, a.Stride, a.Offset)
+	} else {
+		gl.VertexAttribPointerWithOffset(a.Index, a.Size, a.Type, a.Normalized, a.Stride, a.Offset)
+	}
+	// Always set the divisor, since a previous layout may have left a non-zero
+	// divisor on this index.
+	gl.VertexAttribDivisor(a.Index, a.Divisor)
+}
+
+// ApplyLayout applies all attributes of a layout in order. Since each
+// attribute is read from the buffer bound at the time, attributes in different
+// buffers can be applied by binding each buffer and applying its part of the
+// layout separately.
+func ApplyLayout(layout []AttribSpec) {
+	for _, a := range layout {
+		a.Apply()
+	}
+}
+
+// InstancedAttrib sets up a tightly packed, per-instance attribute from the
+// start of the buffer bound to gl.ARRAY_BUFFER, advancing once every divisor
+// instances. Forgetting the divisor makes every instance read the same data.
+func InstancedAttrib(index, size int32, xtype uint32, divisor uint32) {
+	AttribSpec{Index: uint32(index), Size: size, Type: xtype, Divisor: divisor}.Apply()
+}
+
+// VAOReferencesValidBuffers reports whether every enabled attribute of vao, as
+// well as its element array buffer, refers to a buffer that has not been
+// deleted. A buffer deleted while another vertex array is bound stays attached
+// to vao, and drawing with it then reads stale or undefined data.
+//
+// VAOReferencesValidBuffers is meant for debugging; it binds vao temporarily
+// and restores the previous vertex array binding on return.
+func VAOReferencesValidBuffers(vao uint32) bool {
+	prev := uint32(getInteger(gl.VERTEX_ARRAY_BINDING))
+	defer gl.BindVertexArray(prev)
+	gl.BindVertexArray(vao)
+
+	if buf := uint32(getInteger(gl.ELEMENT_ARRAY_BUFFER_BINDING)); buf != 0 && !gl.IsBuffer(buf) {
+		return false
+	}
+	for i := uint32(0); i < uint32(getInteger(gl.MAX_VERTEX_ATTRIBS)); i++ {
+		var enabled, buf int32
+		gl.GetVertexAttribiv(i, gl.VERTEX_ATTRIB_ARRAY_ENABLED, &enabled)
+		if enabled == gl.FALSE {
+			continue
+		}
+		gl.GetVertexAttribiv(i, gl.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING, &buf)
+		if buf != 0 && !gl.IsBuffer(uint32(buf)) {
+			return false
+		}
+	}
+	return true
+}
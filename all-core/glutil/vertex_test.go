@@ -0,0 +1,116 @@
+package glutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+const instancedShader = `#version 330 core
+layout(location = 0) in vec2 offset;
+layout(location = 1) in vec4 color;
+out vec4 vColor;
+void main() {
+	vColor = color;
+	gl_Position = vec4(offset, 0.0, 1.0);
+}
+`
+
+const colorFragmentShader = `#version 330 core
+in vec4 vColor;
+out vec4 fragColor;
+void main() {
+	fragColor = vColor;
+}
+`
+
+// readFramebuffer reads back a width by height RGBA8 region of the read
+// framebuffer starting at the origin.
+func readFramebuffer(width, height int32) []byte {
+	pixels := make([]byte, 4*width*height)
+	gl.ReadPixels(0, 0, width, height, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+	return pixels
+}
+
+func TestInstancedLayout(t *testing.T) {
+	defer testContext(t)()
+	_, deleteFBO := newTestFramebuffer(t, 1)
+	defer deleteFBO()
+	gl.Viewport(0, 0, 4, 4)
+	defer gl.Viewport(0, 0, 64, 64)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+
+	program, err := NewProgram(instancedShader, colorFragmentShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gl.DeleteProgram(program)
+	deleteVAO := bindEmptyVAO()
+	defer deleteVAO()
+
+	// One point per instance, at the centers of pixels (0, 0) and (3, 0).
+	instances := []float32{
+		-0.75, -0.75, 1, 0, 0, 1,
+		0.75, -0.75, 0, 0, 1, 1,
+	}
+	var buf uint32
+	gl.GenBuffers(1, &buf)
+	defer gl.DeleteBuffers(1, &buf)
+	gl.BindBuffer(gl.ARRAY_BUFFER, buf)
+	gl.BufferData(gl.ARRAY_BUFFER, 4*len(instances), gl.Ptr(instances), gl.STATIC_DRAW)
+	ApplyLayout([]AttribSpec{
+		{Index: 0, Size: 2, Type: gl.FLOAT, Stride: 24, Divisor: 1},
+		{Index: 1, Size: 4, Type: gl.FLOAT, Stride: 24, Offset: 8, Divisor: 1},
+	})
+	gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+	for _, index := range []uint32{0, 1} {
+		var divisor int32
+		gl.GetVertexAttribiv(index, gl.VERTEX_ATTRIB_ARRAY_DIVISOR, &divisor)
+		if divisor != 1 {
+			t.Errorf("attribute %d has divisor %d, want 1", index, divisor)
+		}
+	}
+
+	WithProgram(program, func() {
+		gl.DrawArraysInstanced(gl.POINTS, 0, 1, 2)
+	})
+	row := readFramebuffer(4, 1)
+	want := []byte{
+		255, 0, 0, 255,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+		0, 0, 255, 255,
+	}
+	if !bytes.Equal(row, want) {
+		t.Errorf("bottom row = %v, want %v", row, want)
+	}
+	checkErrors(t)
+}
+
+func TestVAOReferencesValidBuffers(t *testing.T) {
+	defer testContext(t)()
+	var vao, buf uint32
+	gl.GenVertexArrays(1, &vao)
+	defer gl.DeleteVertexArrays(1, &vao)
+	gl.GenBuffers(1, &buf)
+	gl.BindVertexArray(vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, buf)
+	gl.BufferData(gl.ARRAY_BUFFER, 16, nil, gl.STATIC_DRAW)
+	AttribSpec{Index: 2, Size: 4, Type: gl.FLOAT}.Apply()
+	gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+	gl.BindVertexArray(0)
+
+	if !VAOReferencesValidBuffers(vao) {
+		t.Error("VAOReferencesValidBuffers() = false for a valid vertex array")
+	}
+	if bound := getInteger(gl.VERTEX_ARRAY_BINDING); bound != 0 {
+		t.Errorf("vertex array binding after VAOReferencesValidBuffers = %d, want 0", bound)
+	}
+	// Deleting the buffer while vao is not bound leaves it attached.
+	gl.DeleteBuffers(1, &buf)
+	if VAOReferencesValidBuffers(vao) {
+		t.Error("VAOReferencesValidBuffers() = true for a vertex array referencing a deleted buffer")
+	}
+	checkErrors(t)
+}
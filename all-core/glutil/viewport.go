@@ -0,0 +1,68 @@
+package glutil
+
+import "github.com/go-gl/gl/all-core/gl"
+
+// viewportArraySupported reports whether indexed viewports and scissor boxes
+// are available.
+func viewportArraySupported() bool {
+	return versionAtLeast(4, 1) || extensionSupported("GL_ARB_viewport_array")
+}
+
+// GetViewports returns all viewports as x, y, width, and height, indexed by
+// the viewport index a geometry shader selects with gl_ViewportIndex. Without
+// viewport array support only the single viewport is returned.
+func GetViewports() [][4]float32 {
+	if !viewportArraySupported() {
+		var v [4]float32
+		gl.GetFloatv(gl.VIEWPORT, &v[0])
+		return [][4]float32{v}
+	}
+	viewports := make([][4]float32, getInteger(gl.MAX_VIEWPORTS))
+	for i := range viewports {
+		gl.GetFloati_v(gl.VIEWPORT, uint32(i), &viewports[i][0])
+	}
+	return viewports
+}
+
+// SetViewport sets the viewport with the given index. Without viewport array
+// support only index zero can be set, and its bounds are truncated to
+// integers; other indices are ignored.
+func SetViewport(index uint32, x, y, width, height float32) {
+	if !viewportArraySupported() {
+		if index == 0 {
+			gl.Viewport(int32(x), int32(y), int32(width), int32(height))
+		}
+		return
+	}
+	gl.ViewportIndexedf(index, x, y, width, height)
+}
+
+// GetScissors returns all scissor boxes as x, y, width, and height, indexed
+// like the viewports. Without viewport array support only the single scissor
+// box is returned.
+func GetScissors() [][4]int32 {
+	if !viewportArraySupported() {
+		var box [4]int32
+		gl.GetIntegerv(gl.SCISSOR_BOX, &box[0])
+		return [][4]int32{box}
+	}
+	boxes := make([][4]int32, getInteger(gl.MAX_VIEWPORTS))
+	for i := range boxes {
+		gl.GetIntegeri_v(gl.SCISSOR_BOX, uint32(i), &boxes[i][0])
+	}
+	return boxes
+}
+
+// SetScissor sets the scissor box used with the viewport of the given index.
+// The scissor test itself is enabled with gl.Enable(gl.SCISSOR_TEST), or per
+// index with glEnablei. Without viewport array support only index zero can be
+// set; other indices are ignored.
+func SetScissor(index uint32, x, y, width, height int32) {
+	if !viewportArraySupported() {
+		if index == 0 {
+			gl.Scissor(x, y, width, height)
+		}
+		return
+	}
+	gl.ScissorIndexed(index, x, y, width, height)
+}
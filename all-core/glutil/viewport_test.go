@@ -0,0 +1,53 @@
+package glutil
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+func TestSetViewport(t *testing.T) {
+	defer testContext(t)()
+	if !viewportArraySupported() {
+		t.Skip("requires OpenGL 4.1 or GL_ARB_viewport_array")
+	}
+	before := GetViewports()
+	if len(before) != int(getInteger(gl.MAX_VIEWPORTS)) {
+		t.Fatalf("GetViewports() returned %d viewports, want MAX_VIEWPORTS", len(before))
+	}
+	prev := before[1]
+	defer SetViewport(1, prev[0], prev[1], prev[2], prev[3])
+
+	SetViewport(1, 8, 16, 32, 24)
+	after := GetViewports()
+	if want := [4]float32{8, 16, 32, 24}; after[1] != want {
+		t.Errorf("viewport 1 = %v, want %v", after[1], want)
+	}
+	if after[0] != before[0] {
+		t.Errorf("setting viewport 1 changed viewport 0 from %v to %v", before[0], after[0])
+	}
+	checkErrors(t)
+}
+
+func TestSetScissor(t *testing.T) {
+	defer testContext(t)()
+	if !viewportArraySupported() {
+		t.Skip("requires OpenGL 4.1 or GL_ARB_viewport_array")
+	}
+	before := GetScissors()
+	if len(before) != int(getInteger(gl.MAX_VIEWPORTS)) {
+		t.Fatalf("GetScissors() returned %d scissor boxes, want MAX_VIEWPORTS", len(before))
+	}
+	prev := before[2]
+	defer SetScissor(2, prev[0], prev[1], prev[2], prev[3])
+
+	SetScissor(2, 4, 8, 16, 12)
+	after := GetScissors()
+	if want := [4]int32{4, 8, 16, 12}; after[2] != want {
+		t.Errorf("scissor box 2 = %v, want %v", after[2], want)
+	}
+	if after[0] != before[0] {
+		t.Errorf("setting scissor box 2 changed scissor box 0 from %v to %v", before[0], after[0])
+	}
+	checkErrors(t)
+}
@@ -0,0 +1,224 @@
+// Command genprocs generates the function pointer tables of the all-core
+// bindings that are used by Context and CoreFunctionsLoaded, as well as the
+// methods of Context mirroring the package-level functions. It also inserts a
+// call into InitWithProcAddrFunc that stops any Context from being current
+// once the function pointers are replaced.
+//
+// It reads the glow-generated package.go files, so it must be re-run whenever
+// the bindings are regenerated. It is invoked by go generate from the
+// repository root, see generate.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// coreVersions are the core profile versions that have their own, strictly
+// initialized, bindings package in this repository.
+var coreVersions = []string{"3.2", "3.3", "4.1", "4.2", "4.3", "4.4", "4.5", "4.6"}
+
+var (
+	loadPattern     = regexp.MustCompile(`(?m)^\tgp(\w+) = \(C\.\w+\)\(getProcAddr\("(\w+)"\)\)$`)
+	requiredPattern = regexp.MustCompile(`return errors\.New\("gl(\w+)"\)`)
+)
+
+const header = "// Code generated by genprocs. DO NOT EDIT.\n\n"
+
+const (
+	initFunc = "func InitWithProcAddrFunc(getProcAddr func(name string) unsafe.Pointer) error {\n"
+	initHook = "\t// Inserted by genprocs: the function pointers of the current Context are\n" +
+		"\t// about to be replaced.\n" +
+		"\tresetCurrentContext()\n"
+)
+
+func main() {
+	root := flag.String("root", ".", "repository root")
+	out := flag.String("out", "", "output directory (default root/all-core/gl)")
+	flag.Parse()
+
+	if *out == "" {
+		*out = filepath.Join(*root, "all-core", "gl")
+	}
+	if err := generate(*root, *out); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate reads the bindings below root and writes procs.go, versions.go and
+// methods.go to the directory out, along with package.go with the Context
+// reset hooked into InitWithProcAddrFunc.
+func generate(root, out string) error {
+	pkg := filepath.Join(root, "all-core", "gl", "package.go")
+	src, err := ioutil.ReadFile(pkg)
+	if err != nil {
+		return err
+	}
+	hooked, err := insertInitHook(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", pkg, err)
+	}
+	// package.go is already formatted by glow, so it is written as is.
+	if err := ioutil.WriteFile(filepath.Join(out, "package.go"), hooked, 0644); err != nil {
+		return err
+	}
+	loaded := make(map[string]bool)
+	var procs bytes.Buffer
+	procs.WriteString(header)
+	procs.WriteString(`package gl
+
+import "unsafe"
+
+// procs lists every function pointer of the package along with the name it is
+// loaded by, in the same order as InitWithProcAddrFunc.
+var procs = []struct {
+	name string
+	ptr  *unsafe.Pointer
+}{
+`)
+	for _, m := range loadPattern.FindAllSubmatch(src, -1) {
+		loaded[string(m[1])] = true
+		fmt.Fprintf(&procs, "\t{%q, (*unsafe.Pointer)(unsafe.Pointer(&gp%s))},\n", m[2], m[1])
+	}
+	procs.WriteString("}\n")
+	if err := write(filepath.Join(out, "procs.go"), procs.Bytes()); err != nil {
+		return err
+	}
+
+	var versions bytes.Buffer
+	versions.WriteString(header)
+	versions.WriteString(`package gl
+
+import "unsafe"
+
+// coreVersions lists, for each core profile version that has its own bindings
+// package in this repository, the functions it requires in addition to the
+// previous entry. The first entry covers all functions up to OpenGL 3.2.
+var coreVersions = []struct {
+	major, minor int
+	functions    []*unsafe.Pointer
+}{
+`)
+	seen := make(map[string]bool)
+	for _, v := range coreVersions {
+		src, err := ioutil.ReadFile(filepath.Join(root, "v"+v+"-core", "gl", "package.go"))
+		if err != nil {
+			return err
+		}
+		var added []string
+		for _, m := range requiredPattern.FindAllSubmatch(src, -1) {
+			name := string(m[1])
+			if seen[name] {
+				continue
+			}
+			if !loaded[name] {
+				return fmt.Errorf("gl%s is required by OpenGL %s but missing from all-core", name, v)
+			}
+			seen[name] = true
+			added = append(added, name)
+		}
+		sort.Slice(added, func(i, j int) bool { return strings.ToLower(added[i]) < strings.ToLower(added[j]) })
+		fmt.Fprintf(&versions, "\t{%s, []*unsafe.Pointer{\n", strings.Replace(v, ".", ", ", 1))
+		for _, name := range added {
+			fmt.Fprintf(&versions, "\t\t(*unsafe.Pointer)(unsafe.Pointer(&gp%s)),\n", name)
+		}
+		versions.WriteString("\t}},\n")
+	}
+	versions.WriteString("}\n")
+	if err := write(filepath.Join(out, "versions.go"), versions.Bytes()); err != nil {
+		return err
+	}
+
+	methods, err := contextMethods(pkg)
+	if err != nil {
+		return err
+	}
+	return write(filepath.Join(out, "methods.go"), methods)
+}
+
+// insertInitHook returns src, the source of the all-core package.go, with a
+// call to resetCurrentContext at the start of InitWithProcAddrFunc. It is left
+// unchanged if the call is already there.
+func insertInitHook(src []byte) ([]byte, error) {
+	i := bytes.Index(src, []byte(initFunc))
+	if i < 0 {
+		return nil, fmt.Errorf("InitWithProcAddrFunc not found")
+	}
+	i += len(initFunc)
+	if bytes.HasPrefix(src[i:], []byte(initHook)) {
+		return src, nil
+	}
+	hooked := make([]byte, 0, len(src)+len(initHook))
+	hooked = append(hooked, src[:i]...)
+	hooked = append(hooked, initHook...)
+	return append(hooked, src[i:]...), nil
+}
+
+// notMethods are the exported functions of package.go that are not OpenGL
+// commands and so have no Context method.
+var notMethods = map[string]bool{
+	"Init":                 true,
+	"InitWithProcAddrFunc": true,
+}
+
+// contextMethods returns the source of a Context method for every exported
+// function declared in the package.go file at path, calling the function
+// after making the Context current.
+func contextMethods(path string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package gl\n\nimport \"unsafe\"\n")
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() || notMethods[fn.Name.Name] {
+			continue
+		}
+		var args []string
+		for _, field := range fn.Type.Params.List {
+			for _, name := range field.Names {
+				// Avoid shadowing the receiver, using glow's "x" prefix for
+				// otherwise conflicting names.
+				if name.Name == "c" {
+					name.Name = "xc"
+				}
+				args = append(args, name.Name)
+			}
+		}
+		var sig bytes.Buffer
+		if err := format.Node(&sig, fset, fn.Type); err != nil {
+			return nil, err
+		}
+		call := fmt.Sprintf("%s(%s)", fn.Name.Name, strings.Join(args, ", "))
+		if fn.Type.Results != nil {
+			call = "return " + call
+		}
+		fmt.Fprintf(&buf, "\n// %s calls %s with c as the current Context.\n", fn.Name.Name, fn.Name.Name)
+		fmt.Fprintf(&buf, "func (c *Context) %s%s {\n\tc.use()\n\t%s\n}\n",
+			fn.Name.Name, strings.TrimPrefix(sig.String(), "func"), call)
+	}
+	return buf.Bytes(), nil
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerated checks that the checked-in output of genprocs is up to date
+// with the bindings it is generated from.
+func TestGenerated(t *testing.T) {
+	out, err := ioutil.TempDir("", "genprocs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+
+	root := filepath.Join("..", "..")
+	if err := generate(root, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"procs.go", "versions.go", "methods.go", "package.go"} {
+		want, err := ioutil.ReadFile(filepath.Join(root, "all-core", "gl", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadFile(filepath.Join(out, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("all-core/gl/%s is out of date; run go generate -tags=gen in the repository root", name)
+		}
+	}
+}
+
+func TestInsertInitHook(t *testing.T) {
+	src := []byte("package gl\n\n" + initFunc + "\treturn nil\n}\n")
+	hooked, err := insertInitHook(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "package gl\n\n" + initFunc + initHook + "\treturn nil\n}\n"; string(hooked) != want {
+		t.Errorf("insertInitHook =\n%s\nwant\n%s", hooked, want)
+	}
+	if again, err := insertInitHook(hooked); err != nil || !bytes.Equal(again, hooked) {
+		t.Errorf("insertInitHook inserted the hook twice:\n%s", again)
+	}
+	if _, err := insertInitHook([]byte("package gl\n")); err == nil {
+		t.Error("insertInitHook succeeded without InitWithProcAddrFunc")
+	}
+}
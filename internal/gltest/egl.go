@@ -0,0 +1,154 @@
+//go:build linux && cgo
+// +build linux,cgo
+
+package gltest
+
+/*
+#cgo LDFLAGS: -ldl
+
+#include <dlfcn.h>
+#include <stdlib.h>
+
+typedef void *EGLDisplay;
+typedef void *EGLConfig;
+typedef void *EGLContext;
+typedef void *EGLSurface;
+typedef int EGLint;
+typedef unsigned int EGLBoolean;
+typedef unsigned int EGLenum;
+
+#define EGL_NONE                             0x3038
+#define EGL_ALPHA_SIZE                       0x3021
+#define EGL_BLUE_SIZE                        0x3022
+#define EGL_GREEN_SIZE                       0x3023
+#define EGL_RED_SIZE                         0x3024
+#define EGL_DEPTH_SIZE                       0x3025
+#define EGL_STENCIL_SIZE                     0x3026
+#define EGL_SURFACE_TYPE                     0x3033
+#define EGL_RENDERABLE_TYPE                  0x3040
+#define EGL_HEIGHT                           0x3056
+#define EGL_WIDTH                            0x3057
+#define EGL_CONTEXT_MAJOR_VERSION            0x3098
+#define EGL_CONTEXT_MINOR_VERSION            0x30FB
+#define EGL_CONTEXT_OPENGL_PROFILE_MASK      0x30FD
+#define EGL_CONTEXT_OPENGL_CORE_PROFILE_BIT  0x0001
+#define EGL_PBUFFER_BIT                      0x0001
+#define EGL_OPENGL_BIT                       0x0008
+#define EGL_OPENGL_API                       0x30A2
+
+static void *egl;
+static EGLDisplay display;
+static EGLContext context;
+static EGLSurface surface;
+
+static EGLDisplay (*pGetDisplay)(void *);
+static EGLBoolean (*pInitialize)(EGLDisplay, EGLint *, EGLint *);
+static EGLBoolean (*pBindAPI)(EGLenum);
+static EGLBoolean (*pChooseConfig)(EGLDisplay, const EGLint *, EGLConfig *, EGLint, EGLint *);
+static EGLSurface (*pCreatePbufferSurface)(EGLDisplay, EGLConfig, const EGLint *);
+static EGLContext (*pCreateContext)(EGLDisplay, EGLConfig, EGLContext, const EGLint *);
+static EGLBoolean (*pMakeCurrent)(EGLDisplay, EGLSurface, EGLSurface, EGLContext);
+static void *(*pGetProcAddress)(const char *);
+
+// gltestCreate loads libEGL and creates a core profile context of at least
+// the given version with a pbuffer surface of the given size. It returns a
+// static error message or NULL on success.
+static const char *gltestCreate(int major, int minor, int width, int height) {
+	egl = dlopen("libEGL.so.1", RTLD_NOW | RTLD_GLOBAL);
+	if (!egl) {
+		return "libEGL.so.1 not found";
+	}
+	pGetDisplay = dlsym(egl, "eglGetDisplay");
+	pInitialize = dlsym(egl, "eglInitialize");
+	pBindAPI = dlsym(egl, "eglBindAPI");
+	pChooseConfig = dlsym(egl, "eglChooseConfig");
+	pCreatePbufferSurface = dlsym(egl, "eglCreatePbufferSurface");
+	pCreateContext = dlsym(egl, "eglCreateContext");
+	pMakeCurrent = dlsym(egl, "eglMakeCurrent");
+	pGetProcAddress = dlsym(egl, "eglGetProcAddress");
+	if (!pGetDisplay || !pInitialize || !pBindAPI || !pChooseConfig ||
+		!pCreatePbufferSurface || !pCreateContext || !pMakeCurrent || !pGetProcAddress) {
+		return "libEGL.so.1 lacks required functions";
+	}
+	display = pGetDisplay(NULL);
+	if (!display || !pInitialize(display, NULL, NULL)) {
+		return "no EGL display";
+	}
+	if (!pBindAPI(EGL_OPENGL_API)) {
+		return "OpenGL API not supported by EGL";
+	}
+	EGLint configAttribs[] = {
+		EGL_SURFACE_TYPE, EGL_PBUFFER_BIT,
+		EGL_RENDERABLE_TYPE, EGL_OPENGL_BIT,
+		EGL_RED_SIZE, 8,
+		EGL_GREEN_SIZE, 8,
+		EGL_BLUE_SIZE, 8,
+		EGL_ALPHA_SIZE, 8,
+		EGL_DEPTH_SIZE, 24,
+		EGL_STENCIL_SIZE, 8,
+		EGL_NONE,
+	};
+	EGLConfig config;
+	EGLint n;
+	if (!pChooseConfig(display, configAttribs, &config, 1, &n) || n < 1) {
+		return "no suitable EGL config";
+	}
+	EGLint surfaceAttribs[] = {EGL_WIDTH, width, EGL_HEIGHT, height, EGL_NONE};
+	surface = pCreatePbufferSurface(display, config, surfaceAttribs);
+	if (!surface) {
+		return "cannot create EGL pbuffer surface";
+	}
+	EGLint contextAttribs[] = {
+		EGL_CONTEXT_MAJOR_VERSION, major,
+		EGL_CONTEXT_MINOR_VERSION, minor,
+		EGL_CONTEXT_OPENGL_PROFILE_MASK, EGL_CONTEXT_OPENGL_CORE_PROFILE_BIT,
+		EGL_NONE,
+	};
+	context = pCreateContext(display, config, NULL, contextAttribs);
+	if (!context) {
+		return "cannot create OpenGL core profile context";
+	}
+	return NULL;
+}
+
+static int gltestMakeCurrent(int bind) {
+	if (bind) {
+		return pMakeCurrent(display, surface, surface, context);
+	}
+	return pMakeCurrent(display, NULL, NULL, NULL);
+}
+
+static void *gltestGetProcAddress(const char *name) {
+	return pGetProcAddress(name);
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+func create(major, minor, width, height int) error {
+	if msg := C.gltestCreate(C.int(major), C.int(minor), C.int(width), C.int(height)); msg != nil {
+		return errors.New(C.GoString(msg))
+	}
+	return nil
+}
+
+func makeCurrent(bind bool) error {
+	b := C.int(0)
+	if bind {
+		b = 1
+	}
+	if C.gltestMakeCurrent(b) == 0 {
+		return errors.New("eglMakeCurrent failed")
+	}
+	return nil
+}
+
+func getProcAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.gltestGetProcAddress(cname)
+}
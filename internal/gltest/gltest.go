@@ -0,0 +1,59 @@
+// Package gltest provides a headless OpenGL context for the tests of the
+// all-core helper packages.
+//
+// The context is created through EGL, which is loaded at run time, so the
+// tests build without EGL being installed and are skipped where no display is
+// available. With Mesa, setting EGL_PLATFORM=surfaceless runs them without a
+// display, on the GPU or in software.
+package gltest
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// Size of the default framebuffer of the context.
+const (
+	Width  = 64
+	Height = 64
+)
+
+var (
+	once      sync.Once
+	createErr error
+)
+
+// Acquire makes the shared test context current on the calling goroutine,
+// which is locked to its OS thread until release is called. The context is
+// created and the all-core bindings initialized on first use. Since the
+// context is shared by all tests, tests must restore any state they change.
+func Acquire() (release func(), err error) {
+	runtime.LockOSThread()
+	once.Do(func() {
+		if createErr = create(3, 2, Width, Height); createErr != nil {
+			return
+		}
+		if createErr = makeCurrent(true); createErr != nil {
+			return
+		}
+		if err := gl.InitWithProcAddrFunc(getProcAddress); err != nil {
+			createErr = fmt.Errorf("gl.InitWithProcAddrFunc: %v", err)
+		}
+	})
+	if createErr == nil {
+		err = makeCurrent(true)
+	} else {
+		err = createErr
+	}
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	return func() {
+		makeCurrent(false)
+		runtime.UnlockOSThread()
+	}, nil
+}
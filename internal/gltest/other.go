@@ -0,0 +1,17 @@
+//go:build !linux || !cgo
+// +build !linux !cgo
+
+package gltest
+
+import (
+	"errors"
+	"unsafe"
+)
+
+var errUnsupported = errors.New("headless OpenGL contexts are only supported on Linux with cgo")
+
+func create(major, minor, width, height int) error { return errUnsupported }
+
+func makeCurrent(bind bool) error { return errUnsupported }
+
+func getProcAddress(name string) unsafe.Pointer { return nil }